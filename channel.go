@@ -0,0 +1,46 @@
+package collection
+
+import (
+        "context"
+        "fmt"
+        "iter"
+        "reflect"
+)
+
+// receive returns a sequence over the values received from the channel, which ends when the channel is closed
+// or when the context is done.
+func receive[K any](ch <-chan K, ctx context.Context) iter.Seq[K] {
+        return func(yield func(K) bool) {
+                for {
+                        select {
+                        case <-ctx.Done():
+                                return
+                        case item, ok := <-ch:
+                                if !ok || !yield(item) {
+                                        return
+                                }
+                        }
+                }
+        }
+}
+
+// send pushes the data into a channel destination, waiting until the channel is ready or the context of the
+// configuration is done. The channel is never closed by the operations, it is up to the caller to close it.
+func send(data any, ch reflect.Value, cfg config) error {
+        elemType := ch.Type().Elem()
+        value := reflect.ValueOf(data)
+        if !value.IsValid() {
+                value = reflect.Zero(elemType)
+        } else if !value.Type().AssignableTo(elemType) {
+                return fmt.Errorf("can not send %T to %v", data, ch.Type())
+        }
+        ctx := cfg.context()
+        chosen, _, _ := reflect.Select([]reflect.SelectCase{
+                {Dir: reflect.SelectSend, Chan: ch, Send: value},
+                {Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+        })
+        if chosen == 1 {
+                return ctx.Err()
+        }
+        return nil
+}
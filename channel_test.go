@@ -0,0 +1,91 @@
+package collection
+
+import (
+        "context"
+        "errors"
+        "reflect"
+        "strings"
+        "testing"
+)
+
+func TestChannelSource(t *testing.T) {
+        users := make(chan testUser)
+        go func() {
+                defer close(users)
+                for _, user := range generateTestCaseList() {
+                        users <- user
+                }
+        }()
+        males := []testUser{}
+        if err := Filter(isMale, (<-chan testUser)(users), &males); err != nil {
+                t.Fatalf("Filter failed: %v", err)
+        }
+        if want := []testUser{john, kyle}; !reflect.DeepEqual(want, males) {
+                t.Errorf("Filter() = %v, want %v", males, want)
+        }
+
+        var nilChannel chan testUser
+        if err := ForEach(func(int, testUser) {}, nilChannel); !errors.Is(err, ErrNilInput) {
+                t.Errorf("ForEach() = %v, want ErrNilInput", err)
+        }
+}
+
+func TestChannelDestination(t *testing.T) {
+        names := make(chan string, 3)
+        var toName Mapper[testUser] = func(tu testUser) any {
+                return tu.name
+        }
+        if err := Map(toName, generateTestCaseList(), (chan<- string)(names)); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        close(names)
+        got := []string{}
+        for name := range names {
+                got = append(got, name)
+        }
+        if want := []string{"John", "Sarah", "Kyle"}; !reflect.DeepEqual(want, got) {
+                t.Errorf("Map() = %v, want %v", got, want)
+        }
+
+        ages := make(chan string, 3)
+        var toAge Mapper[testUser] = func(tu testUser) any {
+                return tu.age
+        }
+        if err := Map(toAge, generateTestCaseList(), ages); err == nil || !strings.Contains(err.Error(), "at index 0") {
+                t.Errorf("Map() = %v, want the failure at index 0", err)
+        }
+        if err := Map(toName, generateTestCaseList(), (<-chan string)(ages)); !errors.Is(err, ErrUnsupportedDestination) {
+                t.Errorf("Map() = %v, want ErrUnsupportedDestination", err)
+        }
+}
+
+func TestWithContext(t *testing.T) {
+        ctx, cancel := context.WithCancel(context.Background())
+        numbers := make(chan int)
+        go func() {
+                for n := 1; n <= 3; n++ {
+                        numbers <- n
+                }
+                cancel()
+        }()
+        got := []int{}
+        err := ForEach(func(index int, n int) { got = append(got, n) }, numbers, WithContext(ctx))
+        if !errors.Is(err, context.Canceled) || !strings.Contains(err.Error(), "at index 3") {
+                t.Errorf("ForEach() = %v, want the cancellation at index 3", err)
+        }
+        if want := []int{1, 2, 3}; !reflect.DeepEqual(want, got) {
+                t.Errorf("ForEach() = %v, want %v", got, want)
+        }
+
+        blocked := make(chan testUser)
+        err = Filter(isMale, generateTestCaseList(), blocked, WithContext(ctx))
+        if !errors.Is(err, context.Canceled) {
+                t.Errorf("Filter() = %v, want context.Canceled", err)
+        }
+
+        doubles := []int{}
+        err = ParallelMap(2, func(n int) any { return n * 2 }, generateNumbers(10), &doubles, WithContext(ctx))
+        if !errors.Is(err, context.Canceled) || len(doubles) != 0 {
+                t.Errorf("ParallelMap() = %v, %v, want context.Canceled and no results", err, doubles)
+        }
+}
@@ -1,9 +1,9 @@
 package collection
 
 import (
-        "errors"
         "fmt"
         "reflect"
+        "runtime/debug"
         "sort"
 )
 
@@ -19,22 +19,33 @@ type Touple struct {
         Value any // Value is the value of the key-value pair.
 }
 
-// builderError struct with an error and the item that caused the error
-type builderError[T any] struct {
+// BuilderError holds the error of an operation together with the item and the index that caused it.
+// It is returned by Zip; when the operation succeeds, Error returns nil.
+type BuilderError[T any] struct {
         err   error
         index int
         item  T
 }
 
 // Method to retrieve the error from the builder
-func (b *builderError[K]) Error() error {
+func (b *BuilderError[K]) Error() error {
         return b.err
 }
 
+// Index returns the position of the item that caused the error.
+func (b *BuilderError[T]) Index() int {
+        return b.index
+}
+
+// Item returns the item that caused the error, or the zero value of T when the error is not related to an item.
+func (b *BuilderError[T]) Item() T {
+        return b.item
+}
+
 type ErrorFormatter[T any] func(int, T) (err error) // New type for error formatting
 
 // Method to set a custom error message in the builder using a function
-func (b *builderError[T]) WithErrorMessage(fn ErrorFormatter[T]) *builderError[T] {
+func (b *BuilderError[T]) WithErrorMessage(fn ErrorFormatter[T]) *BuilderError[T] {
         if fn != nil {
                 b.err = fn(b.index, b.item)
         }
@@ -48,59 +59,94 @@ type Action[T any] func(int, T)
 // ForEach applies the action function to each element in the source collection.
 // Parameters:
 //   - action: a function that takes an index and a value of type T and performs an action.
-//   - source: the collection of elements to iterate over. Must be a slice or array.
+//   - source: the collection of elements to iterate over. A slice, an array, a pointer to one of them, a map or a sequence.
+//   - opts: options that customize the iteration, like ContinueOnError.
 //
 // Returns:
 //   - error: an error if the source is not of the appropriate type or if any other problem occurs during the operation.
-func ForEach[K any](action Action[K], src any) (err error) {
-        builderError := iterate(action, src)
-        if builderError != nil {
-                currentError := builderError.Error()
+func ForEach[K any](action Action[K], src any, opts ...Option) (err error) {
+        return run(infallible(action), src, newConfig(opts))
+}
+
+// formatError decorates the error held by the builder with the item and the index that caused it.
+// It returns nil when the builder is nil, that is, when the iteration finished without errors.
+func formatError[K any](b *BuilderError[K]) (err error) {
+        if b != nil {
+                currentError := b.Error()
                 errorFormatter := func(index int, item K) error {
                         return fmt.Errorf("error processing item %v at index %d: %w", item, index, currentError)
                 }
 
-                err = builderError.WithErrorMessage(errorFormatter).Error()
+                err = b.WithErrorMessage(errorFormatter).Error()
         }
         return
 }
 
-func iterate[K any](action Action[K], src any) *builderError[K] {
-        var errBuilder *builderError[K]
-        evaluate := func(index int, internaParam any) {
-                defer func(index int, item any) {
-                        if err := recover(); err != nil {
-                                valueParametrized := item.(K)
-                                errBuilder = &builderError[K]{
-                                        item:  valueParametrized,
-                                        index: index,
-                                        err:   err.(error),
-                                }
-                        }
-                }(index, internaParam)
-                action(index, internaParam.(K))
+// iterate applies the action to each element of the source, and returns the failures. Unless the configuration
+// asks to continue on error, the iteration stops at the first failure, which is the only one returned.
+// The iteration also stops when the context of the configuration is done, and the interruption is returned apart,
+// or when the action returns ErrStop, which is not a failure.
+func iterate[K any](action ActionErr[K], src any, cfg config) ([]*BuilderError[K], error) {
+        ctx := cfg.context()
+        failures := []*BuilderError[K]{}
+        reached := 0
+        if ctx.Err() != nil {
+                return failures, cfg.interrupted(reached)
         }
-        if IsMap(src) {
-                val := reflect.ValueOf(src)
-                count := -1
-                for _, key := range val.MapKeys() {
-                        count++
-                        value := val.MapIndex(key)
-                        touple := Touple{key.Interface(), value.Interface()}
-                        evaluate(count, touple)
-                }
-        } else {
-                for index, item := range src.([]K) {
-                        if !reflect.ValueOf(errBuilder).IsZero() {
-                                break
+        for index, item := range elements[K](src, cfg) {
+                reached = index + 1
+                if errBuilder := evaluate(action, index, item); errBuilder != nil {
+                        if isStop(errBuilder.err) {
+                                return failures, nil
                         }
-                        if !reflect.ValueOf(errBuilder).IsNil() {
+                        failures = append(failures, errBuilder)
+                        if !cfg.continueOnError {
                                 break
                         }
-                        evaluate(index, item)
+                }
+                if ctx.Err() != nil {
+                        break
                 }
         }
-        return errBuilder
+        return failures, cfg.interrupted(reached)
+}
+
+// evaluate applies the action to a single item, converting the error returned by the action, or a panic raised
+// by it, into a BuilderError that keeps the index and the item that caused it.
+// Any panic value is converted into a *PanicError, so a panic with a value that is not an error can not escape.
+func evaluate[K any](action ActionErr[K], index int, internaParam any) (errBuilder *BuilderError[K]) {
+        defer func(index int, item any) {
+                if value := recover(); value != nil {
+                        valueParametrized, _ := item.(K)
+                        errBuilder = &BuilderError[K]{
+                                item:  valueParametrized,
+                                index: index,
+                                err:   &PanicError{Value: value, Index: index, Item: item, Stack: debug.Stack()},
+                        }
+                }
+        }(index, internaParam)
+        // A nil element is passed as the zero value of K, so interface types like any or error can hold nil elements.
+        // Any other element that is not a K fails the conversion, and the panic is recovered as a *PanicError.
+        item, ok := internaParam.(K)
+        if !ok && internaParam != nil {
+                item = internaParam.(K)
+        }
+        if err := action(index, item); err != nil {
+                errBuilder = &BuilderError[K]{
+                        item:  item,
+                        index: index,
+                        err:   err,
+                }
+        }
+        return
+}
+
+// infallible adapts an Action, which can only fail by panicking, to an ActionErr.
+func infallible[K any](action Action[K]) ActionErr[K] {
+        return func(index int, item K) error {
+                action(index, item)
+                return nil
+        }
 }
 
 // Zip combines two slices into a map, using elements from the keys slice as keys and elements from the values slice as values.
@@ -109,21 +155,47 @@ func iterate[K any](action Action[K], src any) *builderError[K] {
 // keys - the slice of keys.
 // values - the slice of values.
 // result - the map where the keys and values are combined.
-// Returns an error if the operation fails, such as when the lengths of keys and values do not match.
-func Zip[K comparable, V any](keys []K, values []V, result map[K]V) *builderError[K] {
-        b := &builderError[K]{}
-        if len(keys) != len(values) {
-                b.err = errors.New("keys and values slices must have the same length")
+// opts - options that customize the operation, like WithMerge for the keys already in the map; by default they are overwritten.
+// With WithLengthPolicy, slices of different lengths can be truncated to the shortest, or the missing values can be
+// padded with zero values up to the number of keys; missing keys are always rejected, since they can not be made up.
+// Returns a *BuilderError whose Error method returns the error of the operation, or nil if it succeeds;
+// for instance, when the lengths of keys and values do not match.
+func Zip[K comparable, V any](keys []K, values []V, result map[K]V, opts ...Option) *BuilderError[K] {
+        b := &BuilderError[K]{}
+        cfg := newConfig(opts)
+        merge := cfg.mergeFunc(MergeOverwrite)
+        if result == nil {
+                b.err = fmt.Errorf("%w: the result map is nil", ErrNilInput)
+                return b
+        }
+        length, err := cfg.zipLength(len(keys), len(values))
+        if err != nil || length > len(keys) {
+                b.err = fmt.Errorf("%w: keys and values slices must have the same length, got %d keys and %d values",
+                        ErrLengthMismatch, len(keys), len(values))
                 return b
         }
-        for i, key := range keys {
+        for i := range length {
+                key, value := elementAt(keys, i), elementAt(values, i)
                 defer func(k K, v V) {
                         if r := recover(); r != nil {
                                 b.err = fmt.Errorf("error zipping item: %v", r)
                                 b.item = k
                         }
-                }(key, values[i])
-                result[key] = values[i]
+                }(key, value)
+                if existing, found := result[key]; found {
+                        merged, err := merge(key, existing, value)
+                        if err != nil {
+                                b.index, b.item, b.err = i, key, err
+                                b.err = formatError(b)
+                                return b
+                        }
+                        if value, err = typed[V](mapValue(merged, reflect.TypeFor[V]())); err != nil {
+                                b.index, b.item, b.err = i, key, err
+                                b.err = formatError(b)
+                                return b
+                        }
+                }
+                result[key] = value
         }
         return b
 }
@@ -133,36 +205,87 @@ func Zip[K comparable, V any](keys []K, values []V, result map[K]V) *builderErro
 // Returns true if the element is a map, false otherwise.
 func IsMap(elements any) bool {
         t := reflect.TypeOf(elements)
-        return reflect.Map == t.Kind()
+        return t != nil && reflect.Map == t.Kind()
 }
 
-// store inserts data into the destination collection, which can be either a map or a slice.
-// data - the data to be inserted. If dest is a map, data should be of type Touple with Key and Value fields.
+// store inserts data into the destination collection, which can be either a map, a slice or a collection of the package, like Set.
+// data - the data to be inserted. If dest is a map, data should be of type Touple or Entry, with Key and Value fields.
 // dest - the destination collection where the data will be stored; should be a map or a pointer to a slice.
-// If dest is a map, data.(Touple).Key is used as the key and data.(Touple).Value is used as the value.
+// If dest is a map, the Key of the data is used as the key and its Value is used as the value. When the key is
+// already in the map, both values are combined by the merge function of the configuration. A single element
+// stored into a map of slices is wrapped into a slice.
 // If dest is a slice, data is appended to the slice.
-func store(data any, dest any) {
-        if IsMap(dest) {
+func store(data any, dest any, cfg config) error {
+        if container, ok := dest.(container); ok {
+                return container.put(data, cfg.mergeFunc(defaultMerge))
+        } else if IsMap(dest) {
                 val := reflect.ValueOf(dest)
-                keyVal := reflect.ValueOf(data.(Touple).Key)
-                valueVal := reflect.ValueOf(data.(Touple).Value)
-                if val.MapIndex(keyVal).IsValid() {
-                        existingValue := val.MapIndex(keyVal)
-                        if existingValue.Kind() == reflect.Slice && valueVal.Kind() == reflect.Slice {
-                                mergedValue := reflect.AppendSlice(existingValue, valueVal)
-                                val.SetMapIndex(keyVal, mergedValue)
-                        } else {
-                                val.SetMapIndex(keyVal, valueVal)
+                key, value := data.(keyValue).pair()
+                keyVal, err := convertTo(key, val.Type().Key())
+                if err != nil {
+                        return err
+                }
+                valueVal, err := mapValue(value, val.Type().Elem())
+                if err != nil {
+                        return err
+                }
+                if existingValue := val.MapIndex(keyVal); existingValue.IsValid() {
+                        merged, err := cfg.mergeFunc(defaultMerge)(key, existingValue.Interface(), valueVal.Interface())
+                        if err != nil {
+                                return err
+                        }
+                        if valueVal, err = mapValue(merged, val.Type().Elem()); err != nil {
+                                return err
                         }
-                } else {
-                        val.SetMapIndex(keyVal, valueVal)
                 }
+                val.SetMapIndex(keyVal, valueVal)
+        } else if val := reflect.ValueOf(dest); val.Kind() == reflect.Chan {
+                return send(data, val, cfg)
         } else {
                 sliceVal := reflect.ValueOf(dest).Elem()
                 elemVal := reflect.ValueOf(data)
+                if !elemVal.IsValid() {
+                        elemVal = reflect.Zero(sliceVal.Type().Elem())
+                }
                 result := reflect.Append(sliceVal, elemVal)
                 sliceVal.Set(result)
         }
+        return nil
+}
+
+// convertTo converts a value into a reflect.Value that can be assigned to the type typ. A nil value is converted into
+// the zero value of typ, and any other value that can not be assigned to typ is reported as an error.
+func convertTo(value any, typ reflect.Type) (reflect.Value, error) {
+        val := reflect.ValueOf(value)
+        if !val.IsValid() {
+                return reflect.Zero(typ), nil
+        }
+        if !val.Type().AssignableTo(typ) {
+                return reflect.Value{}, fmt.Errorf("can not store %v of type %T as %v", value, value, typ)
+        }
+        return val, nil
+}
+
+// mapValue converts a value stored into a map destination whose values are of type elemType, like convertTo does.
+// When the values of the map are slices, a single element is wrapped into a slice, so the merge functions always
+// receive two slices and the elements stored under the same key are accumulated instead of replaced.
+func mapValue(value any, elemType reflect.Type) (reflect.Value, error) {
+        val := reflect.ValueOf(value)
+        if val.IsValid() && elemType.Kind() == reflect.Slice && !val.Type().AssignableTo(elemType) &&
+                val.Type().AssignableTo(elemType.Elem()) {
+                return reflect.Append(reflect.MakeSlice(elemType, 0, 1), val), nil
+        }
+        return convertTo(value, elemType)
+}
+
+// typed returns the value held by a reflect.Value built by convertTo or mapValue as a T.
+func typed[T any](val reflect.Value, err error) (T, error) {
+        var result T
+        if err != nil {
+                return result, err
+        }
+        result, _ = val.Interface().(T)
+        return result, nil
 }
 
 // Predicate is a function type that takes a value of type T and returns a boolean.
@@ -176,26 +299,24 @@ type Predicate[T any] func(T) bool
 //   - predicate: a function that takes a value of type T and returns a boolean indicating whether the value satisfies the condition.
 //   - source: the collection of elements to be filtered.
 //   - dest: the destination where the results will be stored. Must be a list (slice or array).
+//   - opts: options that customize the iteration, like ContinueOnError.
 //
 // Returns:
 //   - error: an error if the destination is not of the appropriate type or if any other problem occurs during the operation.
-func Filter[T any](predicate Predicate[T], source any, dest any) (err error) {
-        var action Action[T] = func(index int, item T) {
-                if predicate(item) {
-                        store(item, dest)
-                }
+func Filter[T any](predicate Predicate[T], source any, dest any, opts ...Option) (err error) {
+        if err = validateDest(dest); err != nil {
+                return
         }
 
-        builderError := iterate(action, source)
-        if builderError != nil {
-                currentError := builderError.Error()
-                errorFormatter := func(index int, item T) error {
-                        return fmt.Errorf("error processing item %v at index %d: %w", item, index, currentError)
+        cfg := newConfig(opts)
+        var action ActionErr[T] = func(index int, item T) error {
+                if predicate(item) {
+                        return store(item, dest, cfg)
                 }
-
-                err = builderError.WithErrorMessage(errorFormatter).Error()
+                return nil
         }
-        return
+
+        return run(action, source, cfg)
 }
 
 // Mapper is a function type that takes a value of type T and returns a value of any type.
@@ -209,25 +330,22 @@ type Mapper[T any] func(T) any
 //   - mapper: a function that takes a value of type T and returns a transformed value.
 //   - source: the collection of elements to be mapped.
 //   - dest: the destination where the results will be stored. Must be a map or a list (slice or array).
+//   - opts: options that customize the iteration, like ContinueOnError, or WithMerge for the keys already in a map destination.
 //
 // Returns:
 //   - error: an error if the destination is not of the appropriate type or if any other problem occurs during the operation.
-func Map[T any](mapper Mapper[T], source any, dest any) (err error) {
-        var action Action[T] = func(index int, item T) {
-                result := mapper(item)
-                store(result, dest)
+func Map[T any](mapper Mapper[T], source any, dest any, opts ...Option) (err error) {
+        if err = validateDest(dest); err != nil {
+                return
         }
 
-        builderError := iterate(action, source)
-        if builderError != nil {
-                currentError := builderError.Error()
-                errorFormatter := func(index int, item T) error {
-                        return fmt.Errorf("error processing item %v at index %d: %w", item, index, currentError)
-                }
-
-                err = builderError.WithErrorMessage(errorFormatter).Error()
+        cfg := newConfig(opts)
+        var action ActionErr[T] = func(index int, item T) error {
+                result := mapper(item)
+                return store(result, dest, cfg)
         }
-        return
+
+        return run(action, source, cfg)
 }
 
 // KeySelector is a function type that takes a value of type K and returns a value of any type.
@@ -237,30 +355,28 @@ type KeySelector[K any] func(K) any
 
 // GroupBy groups elements from the source using a key selection function (keySelector).
 // The results are stored in the destination (dest), which can only be of type map or a pointer to a list (slice or array).
+// A list receives one element per key, in the order the keys were first seen: a Group when the elements of the list
+// are of type Group, and a Touple holding the key and the items otherwise.
 // Parameters:
 //   - keySelector: a function that takes a value of type T and returns a grouping key.
 //   - source: the collection of elements to be grouped.
 //   - dest: the destination where the results will be stored. Must be a map or a pointer to a list (slice or array).
+//   - opts: options that customize the iteration, like ContinueOnError, or WithMerge for the groups already in a map destination.
 //
 // Returns:
 //   - error: an error if the destination is not of the appropriate type or if any other problem occurs during the operation.
-func GroupBy[T any](keySelector KeySelector[T], source any, dest any) (err error) {
-        var action Action[T] = func(index int, item T) {
-                result := keySelector(item)
-                touple := Touple{result, []T{item}}
-                store(touple, dest)
+func GroupBy[T any](keySelector KeySelector[T], source any, dest any, opts ...Option) (err error) {
+        if err = validateDest(dest); err != nil {
+                return
         }
 
-        builderError := iterate(action, source)
-        if builderError != nil {
-                currentError := builderError.Error()
-                errorFormatter := func(index int, item T) error {
-                        return fmt.Errorf("error processing item %v at index %d: %w", item, index, currentError)
-                }
-
-                err = builderError.WithErrorMessage(errorFormatter).Error()
+        cfg := newConfig(opts)
+        groups := newGroupStore[T](dest, cfg)
+        var action ActionErr[T] = func(index int, item T) error {
+                return groups.add(keySelector(item), item)
         }
-        return
+
+        return run(action, source, cfg)
 }
 
 // Comparator is a function type that takes two values of type T and returns an integer.
@@ -269,7 +385,8 @@ func GroupBy[T any](keySelector KeySelector[T], source any, dest any) (err error
 type Comparator[T any] func(T, T) int
 
 // SortBy sorts the elements in the source using the provided comparator function.
-// The source must be a pointer to a list (array or slice).
+// The source must be a pointer to a list (array or slice). The sort is not stable, use StableSortBy to keep the
+// original order of the equal elements.
 // Parameters:
 //   - comparator: a function that takes two values of type T and returns an integer
 //     indicating their order.
@@ -278,23 +395,36 @@ type Comparator[T any] func(T, T) int
 // Returns:
 //   - error: an error if the source is not of the appropriate type or if any other problem occurs during the operation.
 func SortBy[T any](comparator Comparator[T], source any) error {
-
-        if !IsListUpdatable(source) {
-                return fmt.Errorf("the provided source is not an updatable list (pointer to list): %v", source)
+        lst, err := sortable[T](source)
+        if err != nil {
+                return err
         }
 
-        lst := source.(*[]T)
-        sort.Slice(*lst, func(i, j int) bool {
-                return comparator((*lst)[i], (*lst)[j]) < 0
+        sort.Slice(lst, func(i, j int) bool {
+                return comparator(lst[i], lst[j]) < 0
         })
 
         return nil
 }
 
+// sortable returns the elements of a source that can be sorted in place, that is, a pointer to a list of T,
+// as a slice backed by the source.
+func sortable[T any](source any) ([]T, error) {
+        if source == nil || (IsListUpdatable(source) && reflect.ValueOf(source).IsNil()) {
+                return nil, fmt.Errorf("%w: the source to sort is nil", ErrNilInput)
+        }
+        lst, ok := sliceOf[T](source)
+        if !ok || !IsListUpdatable(source) {
+                return nil, fmt.Errorf("%w: the provided source is not an updatable list (pointer to list) of %v: %v",
+                        ErrUnsupportedSource, reflect.TypeFor[T](), source)
+        }
+        return lst, nil
+}
+
 func IsListUpdatable(source any) bool {
         sourceType := reflect.TypeOf(source)
 
-        return reflect.Ptr == sourceType.Kind() &&
+        return sourceType != nil && reflect.Ptr == sourceType.Kind() &&
                 (reflect.Slice == sourceType.Elem().Kind() ||
                         reflect.Array == sourceType.Elem().Kind())
 }
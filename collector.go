@@ -0,0 +1,108 @@
+package collection
+
+// Number is the constraint of the types that can be summed by Summing and SumBy.
+type Number interface {
+        ~int | ~int8 | ~int16 | ~int32 | ~int64 |
+                ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+                ~float32 | ~float64
+}
+
+// Collector folds the items of a group into a single value of type R, in one pass.
+type Collector[T, R any] struct {
+        Init       func(T) R    // Init builds the value of a new group from its first item.
+        Accumulate func(R, T) R // Accumulate combines the value of the group with another of its items.
+}
+
+// NewCollector creates a new Collector with the given functions.
+func NewCollector[T, R any](init func(T) R, accumulate func(R, T) R) Collector[T, R] {
+        return Collector[T, R]{Init: init, Accumulate: accumulate}
+}
+
+// Counting collects the number of items of each group.
+func Counting[T any]() Collector[T, int] {
+        return NewCollector(func(T) int { return 1 }, func(count int, _ T) int { return count + 1 })
+}
+
+// Summing collects the sum of the values extracted from the items of each group.
+func Summing[T any, N Number](value func(T) N) Collector[T, N] {
+        return NewCollector(value, func(sum N, item T) N { return sum + value(item) })
+}
+
+// MinBy collects the smallest item of each group according to the comparator. On ties, the first item is kept.
+func MinBy[T any](comparator Comparator[T]) Collector[T, T] {
+        return NewCollector(identity[T], func(min T, item T) T {
+                if comparator(item, min) < 0 {
+                        return item
+                }
+                return min
+        })
+}
+
+// MaxBy collects the largest item of each group according to the comparator. On ties, the first item is kept.
+func MaxBy[T any](comparator Comparator[T]) Collector[T, T] {
+        return NewCollector(identity[T], func(max T, item T) T {
+                if comparator(item, max) > 0 {
+                        return item
+                }
+                return max
+        })
+}
+
+// First collects the first item of each group.
+func First[T any]() Collector[T, T] {
+        return NewCollector(identity[T], func(first T, _ T) T { return first })
+}
+
+// Last collects the last item of each group.
+func Last[T any]() Collector[T, T] {
+        return NewCollector(identity[T], func(_ T, item T) T { return item })
+}
+
+// Distinct collects the different items of each group into a Set.
+func Distinct[T comparable]() Collector[T, *Set[T]] {
+        return NewCollector(func(item T) *Set[T] { return NewSet(item) }, func(set *Set[T], item T) *Set[T] {
+                set.Add(item)
+                return set
+        })
+}
+
+func identity[T any](item T) T {
+        return item
+}
+
+// GroupByWith groups the elements of the source by the key returned by the keySelector, folding the items of each
+// group with the collector as they are read, so there is no need to loop again over the groups to count or sum them.
+// If the source is a map, T must be Touple or Entry.
+// Parameters:
+//   - keySelector: a function that takes a value of type T and returns its grouping key.
+//   - collector: the functions that fold the items of a group into its value.
+//   - source: the collection of elements to be grouped.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - map[K]R: the value collected for every key, including the groups collected until the failure.
+//   - error: an error if the source is not of the appropriate type or if any other problem occurs during the operation.
+func GroupByWith[T any, K comparable, R any](keySelector func(T) K, collector Collector[T, R], source any, opts ...Option) (map[K]R, error) {
+        groups := map[K]R{}
+        var action ActionErr[T] = func(index int, item T) error {
+                key := keySelector(item)
+                if value, found := groups[key]; found {
+                        groups[key] = collector.Accumulate(value, item)
+                } else {
+                        groups[key] = collector.Init(item)
+                }
+                return nil
+        }
+        err := run(action, source, newConfig(opts))
+        return groups, err
+}
+
+// CountBy counts the elements of the source by the key returned by the keySelector.
+func CountBy[T any, K comparable](keySelector func(T) K, source any, opts ...Option) (map[K]int, error) {
+        return GroupByWith(keySelector, Counting[T](), source, opts...)
+}
+
+// SumBy sums the values extracted from the elements of the source by the key returned by the keySelector.
+func SumBy[T any, K comparable, N Number](keySelector func(T) K, value func(T) N, source any, opts ...Option) (map[K]N, error) {
+        return GroupByWith(keySelector, Summing(value), source, opts...)
+}
@@ -0,0 +1,51 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "testing"
+)
+
+func TestGroupByWith(t *testing.T) {
+        sex := func(tu testUser) string {
+                return keySelectorBySex(tu).(string)
+        }
+        byAge := func(a, b testUser) int {
+                return a.age - b.age
+        }
+
+        counts, err := CountBy(sex, generateTestCaseList())
+        if want := map[string]int{"male": 2, "female": 1}; err != nil || !reflect.DeepEqual(want, counts) {
+                t.Errorf("CountBy() = %v, %v, want %v", counts, err, want)
+        }
+        ages, err := SumBy(sex, func(tu testUser) int { return tu.age }, generateTestCaseList())
+        if want := map[string]int{"male": 53, "female": 43}; err != nil || !reflect.DeepEqual(want, ages) {
+                t.Errorf("SumBy() = %v, %v, want %v", ages, err, want)
+        }
+
+        collectors := []struct {
+                name      string
+                collector Collector[testUser, testUser]
+                want      testUser
+        }{
+                {"MinBy", MinBy(byAge), john},
+                {"MaxBy", MaxBy(byAge), kyle},
+                {"First", First[testUser](), john},
+                {"Last", Last[testUser](), kyle},
+        }
+        for _, tc := range collectors {
+                got, err := GroupByWith(sex, tc.collector, generateTestCaseList())
+                if err != nil || !reflect.DeepEqual(tc.want, got["male"]) {
+                        t.Errorf("%s = %v, %v, want %v", tc.name, got["male"], err, tc.want)
+                }
+        }
+
+        distinct, err := GroupByWith(func(n int) bool { return n%2 == 0 }, Distinct[int](), []int{1, 2, 1, 3, 2})
+        if err != nil || distinct[true].Len() != 1 || distinct[false].Len() != 2 {
+                t.Errorf("Distinct = %v, %v, want 1 even and 2 odd numbers", distinct, err)
+        }
+
+        if _, err := CountBy(sex, []int{1}); !errors.Is(err, ErrUnsupportedSource) {
+                t.Errorf("CountBy() = %v, want ErrUnsupportedSource", err)
+        }
+}
@@ -0,0 +1,123 @@
+package collection
+
+import (
+        "cmp"
+        "strings"
+)
+
+// NaturalOrder returns the comparator of the natural order of an ordered type, from the smallest to the largest value.
+func NaturalOrder[T cmp.Ordered]() Comparator[T] {
+        return cmp.Compare[T]
+}
+
+// ComparingBy returns a comparator that compares the values by the key extracted from them, in its natural order.
+func ComparingBy[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+        return func(a, b T) int {
+                return cmp.Compare(key(a), key(b))
+        }
+}
+
+// ComparingWith returns a comparator that compares the values by the key extracted from them, using the comparator
+// of the key.
+func ComparingWith[T, K any](key func(T) K, comparator Comparator[K]) Comparator[T] {
+        return func(a, b T) int {
+                return comparator(key(a), key(b))
+        }
+}
+
+// ThenComparing returns a comparator that compares the values with c and, when they are equal, with next.
+// It is used to sort by several columns: ComparingBy(lastName).ThenComparing(ComparingBy(firstName)).
+func (c Comparator[T]) ThenComparing(next Comparator[T]) Comparator[T] {
+        return func(a, b T) int {
+                if result := c(a, b); result != 0 {
+                        return result
+                }
+                return next(a, b)
+        }
+}
+
+// Reversed returns a comparator that imposes the reverse order of c.
+func (c Comparator[T]) Reversed() Comparator[T] {
+        return func(a, b T) int {
+                return c(b, a)
+        }
+}
+
+// NilsFirst returns a comparator of pointers that places the nil pointers before the others,
+// and compares the values pointed by the others with the comparator.
+func NilsFirst[T any](comparator Comparator[T]) Comparator[*T] {
+        return func(a, b *T) int {
+                switch {
+                case a == nil && b == nil:
+                        return 0
+                case a == nil:
+                        return -1
+                case b == nil:
+                        return 1
+                }
+                return comparator(*a, *b)
+        }
+}
+
+// NilsLast returns a comparator of pointers that places the nil pointers after the others,
+// and compares the values pointed by the others with the comparator.
+func NilsLast[T any](comparator Comparator[T]) Comparator[*T] {
+        nilsFirst := NilsFirst(comparator)
+        return func(a, b *T) int {
+                if (a == nil) != (b == nil) {
+                        return -nilsFirst(a, b)
+                }
+                return nilsFirst(a, b)
+        }
+}
+
+// CaseInsensitive compares two strings ignoring the case. Strings that only differ in case are ordered by
+// strings.Compare, so the order is deterministic.
+func CaseInsensitive(a, b string) int {
+        if result := strings.Compare(strings.ToLower(a), strings.ToLower(b)); result != 0 {
+                return result
+        }
+        return strings.Compare(a, b)
+}
+
+// NaturalString compares two strings in natural order: the runs of digits are compared by their numeric value,
+// so "file2" comes before "file10", and the rest of the characters are compared byte by byte.
+// Strings that are equal in natural order, like "file01" and "file1", are ordered by strings.Compare.
+func NaturalString(a, b string) int {
+        i, j := 0, 0
+        for i < len(a) && j < len(b) {
+                if isDigit(a[i]) && isDigit(b[j]) {
+                        numberA, numberB := digitRun(a, i), digitRun(b, j)
+                        i, j = i+len(numberA), j+len(numberB)
+                        numberA, numberB = strings.TrimLeft(numberA, "0"), strings.TrimLeft(numberB, "0")
+                        if result := cmp.Compare(len(numberA), len(numberB)); result != 0 {
+                                return result
+                        }
+                        if result := strings.Compare(numberA, numberB); result != 0 {
+                                return result
+                        }
+                        continue
+                }
+                if result := cmp.Compare(a[i], b[j]); result != 0 {
+                        return result
+                }
+                i, j = i+1, j+1
+        }
+        if result := cmp.Compare(len(a)-i, len(b)-j); result != 0 {
+                return result
+        }
+        return strings.Compare(a, b)
+}
+
+func isDigit(c byte) bool {
+        return '0' <= c && c <= '9'
+}
+
+// digitRun returns the run of digits of s that starts at the index.
+func digitRun(s string, start int) string {
+        end := start
+        for end < len(s) && isDigit(s[end]) {
+                end++
+        }
+        return s[start:end]
+}
@@ -0,0 +1,73 @@
+package collection
+
+import (
+        "reflect"
+        "strings"
+        "testing"
+)
+
+func TestComparatorCombinators(t *testing.T) {
+        users := []testUser{sarah, kyle, john}
+        byAgeThenName := ComparingBy(func(tu testUser) int { return tu.age }).
+                ThenComparing(ComparingBy(func(tu testUser) string { return tu.name }))
+        if err := SortBy(byAgeThenName, &users); err != nil {
+                t.Fatalf("SortBy failed: %v", err)
+        }
+        if want := []testUser{john, kyle, sarah}; !reflect.DeepEqual(want, users) {
+                t.Errorf("SortBy() = %v, want %v", users, want)
+        }
+        if err := SortBy(byAgeThenName.Reversed(), &users); err != nil {
+                t.Fatalf("SortBy failed: %v", err)
+        }
+        if want := []testUser{sarah, kyle, john}; !reflect.DeepEqual(want, users) {
+                t.Errorf("SortBy() = %v, want %v", users, want)
+        }
+
+        byLastName := ComparingWith(func(tu testUser) string { return tu.secondName }, CaseInsensitive)
+        if byLastName(john, sarah) != 0 || byLastName(kyle, john) <= 0 {
+                t.Errorf("ComparingWith() should compare by the last name")
+        }
+
+        numbers := []int{3, 1, 2}
+        if err := SortBy(NaturalOrder[int]().Reversed(), &numbers); err != nil || !reflect.DeepEqual([]int{3, 2, 1}, numbers) {
+                t.Errorf("SortBy() = %v, %v, want [3 2 1]", numbers, err)
+        }
+}
+
+func TestNils(t *testing.T) {
+        one, two := 1, 2
+        pointers := []*int{&two, nil, &one}
+        if err := SortBy(NilsFirst(NaturalOrder[int]()), &pointers); err != nil {
+                t.Fatalf("SortBy failed: %v", err)
+        }
+        if pointers[0] != nil || *pointers[1] != 1 || *pointers[2] != 2 {
+                t.Errorf("NilsFirst() sorted %v, want [nil 1 2]", pointers)
+        }
+        if err := SortBy(NilsLast(NaturalOrder[int]()), &pointers); err != nil {
+                t.Fatalf("SortBy failed: %v", err)
+        }
+        if *pointers[0] != 1 || *pointers[1] != 2 || pointers[2] != nil {
+                t.Errorf("NilsLast() sorted %v, want [1 2 nil]", pointers)
+        }
+}
+
+func TestStringComparators(t *testing.T) {
+        files := []string{"file10", "File2", "file2", "file1", "file01", "file", "a100b2", "a100b10"}
+        if err := SortBy(NaturalString, &files); err != nil {
+                t.Fatalf("SortBy failed: %v", err)
+        }
+        if want := []string{"File2", "a100b2", "a100b10", "file", "file01", "file1", "file2", "file10"}; !reflect.DeepEqual(want, files) {
+                t.Errorf("NaturalString() sorted %v, want %v", files, want)
+        }
+
+        names := []string{"bob", "Alice", "alice", "Carol"}
+        if err := SortBy(CaseInsensitive, &names); err != nil {
+                t.Fatalf("SortBy failed: %v", err)
+        }
+        if want := []string{"Alice", "alice", "bob", "Carol"}; !reflect.DeepEqual(want, names) {
+                t.Errorf("CaseInsensitive() sorted %v, want %v", names, want)
+        }
+        if CaseInsensitive("ABC", strings.ToLower("ABC")) >= 0 {
+                t.Errorf("CaseInsensitive() should break ties with strings.Compare")
+        }
+}
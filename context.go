@@ -0,0 +1,142 @@
+package collection
+
+import (
+        "context"
+        "fmt"
+        "sort"
+)
+
+// ActionCtx is the context-aware counterpart of ActionErr: it receives the context of the operation,
+// so it can stop a long-running action or pass the context to the functions it calls.
+type ActionCtx[T any] func(context.Context, int, T) error
+
+// PredicateCtx is the context-aware counterpart of PredicateErr.
+type PredicateCtx[T any] func(context.Context, T) (bool, error)
+
+// MapperCtx is the context-aware counterpart of MapperErr.
+type MapperCtx[T any] func(context.Context, T) (any, error)
+
+// KeySelectorCtx is the context-aware counterpart of KeySelector: it returns the grouping key of the value, or an error.
+type KeySelectorCtx[T any] func(context.Context, T) (any, error)
+
+// ForEachCtx applies the action function to each element in the source collection until the context is done.
+// Parameters:
+//   - ctx: the context that cancels the operation. It is checked between elements and passed to the action.
+//   - action: a function that takes the context, an index and a value of type T, performs an action and returns an error if it fails.
+//   - source: the collection of elements to iterate over.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - error: the error returned by the action, wrapped with the item and the index that caused it, or the error of the
+//     context wrapped with the index of the first element not processed.
+func ForEachCtx[T any](ctx context.Context, action ActionCtx[T], source any, opts ...Option) error {
+        var actionErr ActionErr[T] = func(index int, item T) error {
+                return action(ctx, index, item)
+        }
+        return ForEachErr(actionErr, source, withContext(ctx, opts)...)
+}
+
+// FilterCtx filters elements from the source using a context-aware predicate until the context is done,
+// and stores the results in the destination.
+// Parameters:
+//   - ctx: the context that cancels the operation. It is checked between elements and passed to the predicate.
+//   - predicate: a function that takes the context and a value of type T and returns whether the value satisfies the condition, or an error.
+//   - source: the collection of elements to be filtered.
+//   - dest: the destination where the results will be stored. Must be a map, a pointer to a list or a channel.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - error: the error returned by the predicate, wrapped with the item and the index that caused it, or the error of the
+//     context wrapped with the index of the first element not processed.
+func FilterCtx[T any](ctx context.Context, predicate PredicateCtx[T], source any, dest any, opts ...Option) error {
+        var predicateErr PredicateErr[T] = func(item T) (bool, error) {
+                return predicate(ctx, item)
+        }
+        return FilterErr(predicateErr, source, dest, withContext(ctx, opts)...)
+}
+
+// MapCtx applies a context-aware mapper to each element in the source until the context is done,
+// and stores the results in the destination.
+// Parameters:
+//   - ctx: the context that cancels the operation. It is checked between elements and passed to the mapper.
+//   - mapper: a function that takes the context and a value of type T and returns a transformed value, or an error.
+//   - source: the collection of elements to be mapped.
+//   - dest: the destination where the results will be stored. Must be a map, a pointer to a list or a channel.
+//   - opts: options that customize the iteration, like ContinueOnError, or WithMerge for the keys already in a map destination.
+//
+// Returns:
+//   - error: the error returned by the mapper, wrapped with the item and the index that caused it, or the error of the
+//     context wrapped with the index of the first element not processed.
+func MapCtx[T any](ctx context.Context, mapper MapperCtx[T], source any, dest any, opts ...Option) error {
+        var mapperErr MapperErr[T] = func(item T) (any, error) {
+                return mapper(ctx, item)
+        }
+        return MapErr(mapperErr, source, dest, withContext(ctx, opts)...)
+}
+
+// GroupByCtx groups elements from the source using a context-aware key selector until the context is done.
+// Parameters:
+//   - ctx: the context that cancels the operation. It is checked between elements and passed to the key selector.
+//   - keySelector: a function that takes the context and a value of type T and returns a grouping key, or an error.
+//   - source: the collection of elements to be grouped.
+//   - dest: the destination where the results will be stored. Must be a map or a pointer to a list.
+//   - opts: options that customize the iteration, like ContinueOnError, or WithMerge for the groups already in a map destination.
+//
+// Returns:
+//   - error: the error returned by the key selector, wrapped with the item and the index that caused it, or the error of
+//     the context wrapped with the index of the first element not processed.
+func GroupByCtx[T any](ctx context.Context, keySelector KeySelectorCtx[T], source any, dest any, opts ...Option) (err error) {
+        if err = validateDest(dest); err != nil {
+                return
+        }
+
+        cfg := newConfig(withContext(ctx, opts))
+        groups := newGroupStore[T](dest, cfg)
+        var action ActionErr[T] = func(index int, item T) error {
+                key, err := keySelector(ctx, item)
+                if err != nil {
+                        return err
+                }
+                return groups.add(key, item)
+        }
+        return run(action, source, cfg)
+}
+
+// SortByCtx sorts the elements in the source using the provided comparator function until the context is done.
+// The elements are sorted in a copy that replaces the content of the source only when the sort finishes,
+// so a cancelled sort leaves the source untouched.
+// Parameters:
+//   - ctx: the context that cancels the operation. It is checked between comparisons.
+//   - comparator: a function that takes two values of type T and returns an integer indicating their order.
+//   - source: a pointer to the list (array or slice) to be sorted.
+//
+// Returns:
+//   - error: an error if the source is not of the appropriate type, or the error of the context.
+func SortByCtx[T any](ctx context.Context, comparator Comparator[T], source any) error {
+        lst, err := sortable[T](source)
+        if err != nil {
+                return err
+        }
+
+        done := ctx.Done()
+        sorted := append([]T{}, lst...)
+        sort.Slice(sorted, func(i, j int) bool {
+                select {
+                case <-done:
+                        return false
+                default:
+                        return comparator(sorted[i], sorted[j]) < 0
+                }
+        })
+        if err := ctx.Err(); err != nil {
+                return fmt.Errorf("sort cancelled: %w", err)
+        }
+        copy(lst, sorted)
+        return nil
+}
+
+// withContext returns the options with WithContext appended, so the context of a ...Ctx operation takes
+// precedence over any other set in the options.
+func withContext(ctx context.Context, opts []Option) []Option {
+        return append(opts[:len(opts):len(opts)], WithContext(ctx))
+}
@@ -0,0 +1,108 @@
+package collection
+
+import (
+        "context"
+        "errors"
+        "reflect"
+        "strings"
+        "testing"
+        "time"
+)
+
+type ctxKey struct{}
+
+func TestForEachCtx(t *testing.T) {
+        ctx, cancel := context.WithCancel(context.Background())
+        defer cancel()
+        visited := []int{}
+        var action ActionCtx[int] = func(ctx context.Context, index int, n int) error {
+                visited = append(visited, n)
+                if n == 2 {
+                        cancel()
+                }
+                return nil
+        }
+        err := ForEachCtx(ctx, action, generateNumbers(10))
+        if !errors.Is(err, context.Canceled) || !strings.Contains(err.Error(), "at index 3") {
+                t.Errorf("ForEachCtx() = %v, want the cancellation at index 3", err)
+        }
+        if want := []int{0, 1, 2}; !reflect.DeepEqual(want, visited) {
+                t.Errorf("ForEachCtx() = %v, want %v", visited, want)
+        }
+}
+
+func TestMapAndFilterCtx(t *testing.T) {
+        ctx := context.WithValue(context.Background(), ctxKey{}, " (checked)")
+        var mapper MapperCtx[testUser] = func(ctx context.Context, tu testUser) (any, error) {
+                return tu.name + ctx.Value(ctxKey{}).(string), nil
+        }
+        names := []string{}
+        if err := MapCtx(ctx, mapper, generateTestCaseList(), &names); err != nil {
+                t.Fatalf("MapCtx failed: %v", err)
+        }
+        if want := []string{"John (checked)", "Sarah (checked)", "Kyle (checked)"}; !reflect.DeepEqual(want, names) {
+                t.Errorf("MapCtx() = %v, want %v", names, want)
+        }
+
+        var predicate PredicateCtx[testUser] = func(ctx context.Context, tu testUser) (bool, error) {
+                if tu.age > 40 {
+                        return false, errOdd
+                }
+                return tu.male, nil
+        }
+        males := []testUser{}
+        err := FilterCtx(ctx, predicate, generateTestCaseList(), &males)
+        if !errors.Is(err, errOdd) || !strings.Contains(err.Error(), "at index 1") {
+                t.Errorf("FilterCtx() = %v, want the failure at index 1", err)
+        }
+        if want := []testUser{john}; !reflect.DeepEqual(want, males) {
+                t.Errorf("FilterCtx() = %v, want %v", males, want)
+        }
+}
+
+func TestGroupByCtx(t *testing.T) {
+        var keySelector KeySelectorCtx[testUser] = func(ctx context.Context, tu testUser) (any, error) {
+                return keySelectorBySex(tu), nil
+        }
+        groups := map[string][]testUser{}
+        if err := GroupByCtx(context.Background(), keySelector, generateTestCaseList(), groups); err != nil {
+                t.Fatalf("GroupByCtx failed: %v", err)
+        }
+        if want := map[string][]testUser{"male": {john, kyle}, "female": {sarah}}; !reflect.DeepEqual(want, groups) {
+                t.Errorf("GroupByCtx() = %v, want %v", groups, want)
+        }
+
+        expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+        defer cancel()
+        groups = map[string][]testUser{}
+        err := GroupByCtx(expired, keySelector, generateTestCaseList(), groups)
+        if !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "at index 0") || len(groups) != 0 {
+                t.Errorf("GroupByCtx() = %v, %v, want the deadline at index 0 and no groups", err, groups)
+        }
+}
+
+func TestSortByCtx(t *testing.T) {
+        ascending := func(a, b int) int {
+                return a - b
+        }
+        numbers := []int{3, 1, 2}
+        if err := SortByCtx(context.Background(), ascending, &numbers); err != nil {
+                t.Fatalf("SortByCtx failed: %v", err)
+        }
+        if want := []int{1, 2, 3}; !reflect.DeepEqual(want, numbers) {
+                t.Errorf("SortByCtx() = %v, want %v", numbers, want)
+        }
+
+        ctx, cancel := context.WithCancel(context.Background())
+        cancel()
+        numbers = []int{3, 1, 2}
+        if err := SortByCtx(ctx, ascending, &numbers); !errors.Is(err, context.Canceled) {
+                t.Errorf("SortByCtx() = %v, want context.Canceled", err)
+        }
+        if want := []int{3, 1, 2}; !reflect.DeepEqual(want, numbers) {
+                t.Errorf("SortByCtx() = %v, want the source untouched %v", numbers, want)
+        }
+        if err := SortByCtx(ctx, ascending, numbers); !errors.Is(err, ErrUnsupportedSource) {
+                t.Errorf("SortByCtx() = %v, want ErrUnsupportedSource", err)
+        }
+}
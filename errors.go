@@ -0,0 +1,98 @@
+package collection
+
+import (
+        "errors"
+        "fmt"
+        "strings"
+)
+
+var (
+        // ErrUnsupportedSource is wrapped by the error returned when the source is not a collection the operation can iterate.
+        ErrUnsupportedSource = errors.New("unsupported source")
+        // ErrUnsupportedDestination is wrapped by the error returned when the destination is not a collection the operation can fill.
+        ErrUnsupportedDestination = errors.New("unsupported destination")
+        // ErrLengthMismatch is wrapped by the error returned when two collections that must have the same length do not.
+        ErrLengthMismatch = errors.New("length mismatch")
+        // ErrNilInput is wrapped by the error returned when the source or the destination is nil.
+        ErrNilInput = errors.New("nil input")
+        // ErrEmptySource is returned by the operations that need at least one element, like Reduce, when the source is empty.
+        ErrEmptySource = errors.New("the source has no elements")
+        // ErrDuplicateKey is wrapped by the error returned when a key is already in the map destination and the merge
+        // policy is MergeErrorOnDuplicate.
+        ErrDuplicateKey = errors.New("duplicate key")
+        // ErrIndexOutOfRange is wrapped by the error returned when a position is out of the elements of the source,
+        // like the n passed to NthElement.
+        ErrIndexOutOfRange = errors.New("index out of range")
+        // ErrStop can be returned by the functions passed to the operations to stop the iteration early.
+        // It is not reported as a failure: the operation returns nil, keeping the results stored so far.
+        ErrStop = errors.New("stop iteration")
+)
+
+// ItemError is the failure of a single element of the source: it keeps the error together with the item
+// and the index that caused it.
+type ItemError struct {
+        Index int // Index is the position of the item in the source.
+        Item  any // Item is the element of the source that failed.
+        Err   error
+}
+
+func (e *ItemError) Error() string {
+        return fmt.Sprintf("error processing item %v at index %d: %v", e.Item, e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error, so errors.Is and errors.As can inspect it.
+func (e *ItemError) Unwrap() error {
+        return e.Err
+}
+
+// AggregateError gathers every failure of an operation executed with ContinueOnError.
+// Like the errors built by errors.Join, it unwraps to the list of failures, so errors.Is and errors.As
+// match any of them.
+type AggregateError struct {
+        Failures []*ItemError // Failures are sorted by the position of the item in the source.
+}
+
+func (e *AggregateError) Error() string {
+        messages := make([]string, len(e.Failures))
+        for index, failure := range e.Failures {
+                messages[index] = failure.Error()
+        }
+        return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the failures as a list of errors.
+func (e *AggregateError) Unwrap() []error {
+        errs := make([]error, len(e.Failures))
+        for index, failure := range e.Failures {
+                errs[index] = failure
+        }
+        return errs
+}
+
+// PanicError is the error produced when a function passed to an operation panics. It keeps the original panic value,
+// the index and the item that were being processed, and the stack trace captured when the panic was recovered.
+type PanicError struct {
+        Value any    // Value is the value passed to panic.
+        Index int    // Index is the position of the item in the source.
+        Item  any    // Item is the element of the source that was being processed.
+        Stack []byte // Stack is the stack trace of the goroutine that panicked.
+}
+
+func (e *PanicError) Error() string {
+        return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value when it is an error, so errors.Is and errors.As can inspect it.
+func (e *PanicError) Unwrap() error {
+        if err, ok := e.Value.(error); ok {
+                return err
+        }
+        return nil
+}
+
+// isStop reports whether the failure is a request to stop the iteration, that is, ErrStop returned by the function
+// passed to the operation. A panic with ErrStop is still a failure.
+func isStop(err error) bool {
+        var panicErr *PanicError
+        return errors.Is(err, ErrStop) && !errors.As(err, &panicErr)
+}
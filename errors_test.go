@@ -0,0 +1,83 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "strconv"
+        "testing"
+)
+
+func TestContinueOnError(t *testing.T) {
+        var atoi MapperErr[string] = func(s string) (any, error) {
+                return strconv.Atoi(s)
+        }
+        got := []int{}
+        err := MapErr(atoi, []string{"1", "two", "3", "four"}, &got, ContinueOnError())
+
+        if want := []int{1, 3}; !reflect.DeepEqual(want, got) {
+                t.Errorf("MapErr() = %v, want %v", got, want)
+        }
+
+        var aggregate *AggregateError
+        if !errors.As(err, &aggregate) {
+                t.Fatalf("MapErr() = %v, want an *AggregateError", err)
+        }
+        if len(aggregate.Failures) != 2 {
+                t.Fatalf("MapErr() failures = %d, want 2", len(aggregate.Failures))
+        }
+        if failure := aggregate.Failures[1]; failure.Index != 3 || failure.Item != "four" {
+                t.Errorf("MapErr() failure = %v, want the item four at index 3", failure)
+        }
+        if !errors.Is(err, strconv.ErrSyntax) {
+                t.Errorf("MapErr() = %v, want to match %v", err, strconv.ErrSyntax)
+        }
+}
+
+func TestContinueOnErrorWithPanics(t *testing.T) {
+        var actionKO Action[testUser] = func(i int, tu testUser) {
+                if tu.male {
+                        panic(errOdd)
+                }
+        }
+        err := ForEach(actionKO, generateTestCaseList(), ContinueOnError())
+
+        var aggregate *AggregateError
+        if !errors.As(err, &aggregate) || len(aggregate.Failures) != 2 {
+                t.Fatalf("ForEach() = %v, want two failures", err)
+        }
+        if !errors.Is(err, errOdd) {
+                t.Errorf("ForEach() = %v, want to match %v", err, errOdd)
+        }
+        if err := ForEach(actionKO, []testUser{sarah}, ContinueOnError()); err != nil {
+                t.Errorf("ForEach() = %v, want nil", err)
+        }
+}
+
+func TestPanicError(t *testing.T) {
+        var mapperKO Mapper[int] = func(n int) any {
+                if n == 2 {
+                        panic("bad")
+                }
+                return n
+        }
+        got := []int{}
+        err := Map(mapperKO, []int{1, 2, 3}, &got)
+
+        var panicErr *PanicError
+        if !errors.As(err, &panicErr) {
+                t.Fatalf("Map() = %v, want a *PanicError", err)
+        }
+        if panicErr.Value != "bad" || panicErr.Index != 1 || panicErr.Item != 2 {
+                t.Errorf("Map() = %+v, want the value bad for the item 2 at index 1", panicErr)
+        }
+        if len(panicErr.Stack) == 0 {
+                t.Errorf("Map() should capture the stack trace")
+        }
+        if want := "error processing item 2 at index 1: panic: bad"; err.Error() != want {
+                t.Errorf("Map() = %q, want %q", err.Error(), want)
+        }
+
+        if err := ForEach(func(int, testUser) {}, generateTestCaseMap()); !errors.Is(err, ErrUnsupportedSource) {
+                t.Errorf("ForEach() = %v, want ErrUnsupportedSource for a map iterated as testUser", err)
+        }
+}
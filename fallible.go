@@ -0,0 +1,81 @@
+package collection
+
+// ActionErr is a function type that takes an index and a value of type T and returns an error.
+// It is the error-returning counterpart of Action: returning a non-nil error stops the iteration.
+// Returning ErrStop stops it too, but without reporting a failure.
+type ActionErr[T any] func(int, T) error
+
+// PredicateErr is a function type that takes a value of type T and returns a boolean and an error.
+// It is the error-returning counterpart of Predicate.
+type PredicateErr[T any] func(T) (bool, error)
+
+// MapperErr is a function type that takes a value of type T and returns a value of any type and an error.
+// It is the error-returning counterpart of Mapper.
+type MapperErr[T any] func(T) (any, error)
+
+// ForEachErr applies the action function to each element in the source collection, stopping at the first error.
+// Parameters:
+//   - action: a function that takes an index and a value of type T, performs an action and returns an error if it fails.
+//   - source: the collection of elements to iterate over.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - error: the error returned by the action, wrapped with the item and the index that caused it.
+func ForEachErr[T any](action ActionErr[T], source any, opts ...Option) (err error) {
+        return run(action, source, newConfig(opts))
+}
+
+// FilterErr filters elements from the source using a predicate function that can fail, and stores the results
+// in the destination. The filtering stops at the first error.
+// Parameters:
+//   - predicate: a function that takes a value of type T and returns whether the value satisfies the condition, or an error.
+//   - source: the collection of elements to be filtered.
+//   - dest: the destination where the results will be stored. Must be a map or a pointer to a list.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - error: the error returned by the predicate, wrapped with the item and the index that caused it.
+func FilterErr[T any](predicate PredicateErr[T], source any, dest any, opts ...Option) (err error) {
+        if err = validateDest(dest); err != nil {
+                return
+        }
+
+        cfg := newConfig(opts)
+        var action ActionErr[T] = func(index int, item T) error {
+                keep, err := predicate(item)
+                if err != nil {
+                        return err
+                }
+                if keep {
+                        return store(item, dest, cfg)
+                }
+                return nil
+        }
+        return run(action, source, cfg)
+}
+
+// MapErr applies a mapper function that can fail to each element in the source, and stores the results in the
+// destination. The mapping stops at the first error.
+// Parameters:
+//   - mapper: a function that takes a value of type T and returns a transformed value, or an error.
+//   - source: the collection of elements to be mapped.
+//   - dest: the destination where the results will be stored. Must be a map or a pointer to a list.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - error: the error returned by the mapper, wrapped with the item and the index that caused it.
+func MapErr[T any](mapper MapperErr[T], source any, dest any, opts ...Option) (err error) {
+        if err = validateDest(dest); err != nil {
+                return
+        }
+
+        cfg := newConfig(opts)
+        var action ActionErr[T] = func(index int, item T) error {
+                result, err := mapper(item)
+                if err != nil {
+                        return err
+                }
+                return store(result, dest, cfg)
+        }
+        return run(action, source, cfg)
+}
@@ -0,0 +1,62 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "strconv"
+        "testing"
+)
+
+var errOdd = errors.New("odd number")
+
+func TestForEachErr(t *testing.T) {
+        visited := []int{}
+        var action ActionErr[int] = func(index int, n int) error {
+                if n%2 != 0 {
+                        return errOdd
+                }
+                visited = append(visited, n)
+                return nil
+        }
+        err := ForEachErr(action, []int{2, 4, 5, 6})
+        if !errors.Is(err, errOdd) {
+                t.Fatalf("ForEachErr() = %v, want %v", err, errOdd)
+        }
+        if want := "error processing item 5 at index 2: odd number"; err.Error() != want {
+                t.Errorf("ForEachErr() = %q, want %q", err.Error(), want)
+        }
+        if want := []int{2, 4}; !reflect.DeepEqual(want, visited) {
+                t.Errorf("ForEachErr() visited = %v, want %v", visited, want)
+        }
+}
+
+func TestFilterErr(t *testing.T) {
+        var isEven PredicateErr[int] = func(n int) (bool, error) {
+                return n%2 == 0, nil
+        }
+        got := []int{}
+        if err := FilterErr(isEven, []int{1, 2, 3, 4}, &got); err != nil {
+                t.Fatalf("FilterErr failed: %v", err)
+        }
+        if want := []int{2, 4}; !reflect.DeepEqual(want, got) {
+                t.Errorf("FilterErr() = %v, want %v", got, want)
+        }
+}
+
+func TestMapErr(t *testing.T) {
+        var atoi MapperErr[string] = func(s string) (any, error) {
+                return strconv.Atoi(s)
+        }
+        got := []int{}
+        if err := MapErr(atoi, []string{"1", "2"}, &got); err != nil {
+                t.Fatalf("MapErr failed: %v", err)
+        }
+        if want := []int{1, 2}; !reflect.DeepEqual(want, got) {
+                t.Errorf("MapErr() = %v, want %v", got, want)
+        }
+
+        var numErr *strconv.NumError
+        if err := MapErr(atoi, []string{"1", "two"}, &got); !errors.As(err, &numErr) {
+                t.Errorf("MapErr() = %v, want a *strconv.NumError", err)
+        }
+}
@@ -1,6 +1,6 @@
 module github.com/jose78/go-collection
 
-go 1.22.0
+go 1.23.0
 
 
 retract (
@@ -0,0 +1,87 @@
+package collection
+
+import (
+        "fmt"
+        "reflect"
+)
+
+// Group holds the items that share the same key. It is the element GroupBy stores into a slice destination,
+// one per key, and it can be stored into a map destination like a Touple.
+type Group[K comparable, T any] struct {
+        Key   K   // Key is the key shared by the items of the group.
+        Items []T // Items are the items of the group, in the order of the source.
+}
+
+// groupBuilder is implemented by the element types of a slice destination that GroupBy can build from a key and
+// the items of its group, like Group.
+type groupBuilder interface {
+        group(key any, items any) (any, bool)
+}
+
+func (g Group[K, T]) group(key any, items any) (any, bool) {
+        typedKey, okKey := key.(K)
+        typedItems, okItems := items.([]T)
+        if !okKey || !okItems {
+                return nil, false
+        }
+        return Group[K, T]{typedKey, typedItems}, true
+}
+
+func (g Group[K, T]) pair() (any, any) {
+        return g.Key, g.Items
+}
+
+// groupStore stores the items grouped by GroupBy into its destination. The maps and the collections of the package
+// receive a Touple per item, merged by store, while a slice receives a single element per key, in the order the
+// keys were first seen, which is updated as the items of its group are read.
+type groupStore[T any] struct {
+        dest      any
+        cfg       config
+        positions map[any]int
+        items     map[any][]T
+}
+
+func newGroupStore[T any](dest any, cfg config) *groupStore[T] {
+        return &groupStore[T]{dest: dest, cfg: cfg, positions: map[any]int{}, items: map[any][]T{}}
+}
+
+// add stores the item into the group of the key.
+func (g *groupStore[T]) add(key any, item T) error {
+        sliceVal := reflect.ValueOf(g.dest)
+        if sliceVal.Kind() != reflect.Ptr || sliceVal.Elem().Kind() != reflect.Slice {
+                return store(Touple{key, []T{item}}, g.dest, g.cfg)
+        }
+        sliceVal = sliceVal.Elem()
+
+        items := append(g.items[key], item)
+        group, err := groupOf(key, items, sliceVal.Type().Elem())
+        if err != nil {
+                return err
+        }
+        g.items[key] = items
+        if position, found := g.positions[key]; found {
+                sliceVal.Index(position).Set(group)
+        } else {
+                g.positions[key] = sliceVal.Len()
+                sliceVal.Set(reflect.Append(sliceVal, group))
+        }
+        return nil
+}
+
+// groupOf builds the element of a slice of elemType that holds the key and the items of a group:
+// a Group when elemType is a Group, and a Touple otherwise.
+func groupOf[T any](key any, items []T, elemType reflect.Type) (reflect.Value, error) {
+        if builder, ok := reflect.Zero(elemType).Interface().(groupBuilder); ok {
+                group, ok := builder.group(key, items)
+                if !ok {
+                        return reflect.Value{}, fmt.Errorf("can not store the key %v (%T) and the items %T into a slice of %v",
+                                key, key, items, elemType)
+                }
+                return reflect.ValueOf(group), nil
+        }
+        touple := reflect.ValueOf(Touple{key, items})
+        if !touple.Type().AssignableTo(elemType) {
+                return reflect.Value{}, fmt.Errorf("%w: can not store the groups into a slice of %v", ErrUnsupportedDestination, elemType)
+        }
+        return touple, nil
+}
@@ -0,0 +1,46 @@
+package collection
+
+import (
+        "reflect"
+        "testing"
+)
+
+func TestGroupByIntoSlice(t *testing.T) {
+        groups := []Group[string, testUser]{}
+        if err := GroupBy(keySelectorBySex, generateTestCaseList(), &groups); err != nil {
+                t.Fatalf("GroupBy failed: %v", err)
+        }
+        want := []Group[string, testUser]{{"male", []testUser{john, kyle}}, {"female", []testUser{sarah}}}
+        if !reflect.DeepEqual(want, groups) {
+                t.Errorf("GroupBy() = %v, want %v", groups, want)
+        }
+
+        touples := []Touple{}
+        if err := GroupBy(keySelectorBySex, []testUser{sarah, john, kyle}, &touples); err != nil {
+                t.Fatalf("GroupBy failed: %v", err)
+        }
+        if wantTouples := []Touple{{"female", []testUser{sarah}}, {"male", []testUser{john, kyle}}}; !reflect.DeepEqual(wantTouples, touples) {
+                t.Errorf("GroupBy() = %v, want %v", touples, wantTouples)
+        }
+
+        byAge := []Group[string, testUser]{}
+        if err := GroupBy(func(tu testUser) any { return tu.age }, generateTestCaseList(), &byAge); err == nil {
+                t.Errorf("GroupBy() should fail when the key does not match the type of the Group")
+        }
+        if err := GroupBy(keySelectorBySex, generateTestCaseList(), &[]int{}); err == nil {
+                t.Errorf("GroupBy() should fail when the elements of the slice can not hold a group")
+        }
+}
+
+func TestGroupIntoMap(t *testing.T) {
+        groups := map[string][]testUser{}
+        var toGroup Mapper[testUser] = func(tu testUser) any {
+                return Group[string, testUser]{tu.name, []testUser{tu}}
+        }
+        if err := Map(toGroup, generateTestCaseList(), groups); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        if want := map[string][]testUser{"John": {john}, "Sarah": {sarah}, "Kyle": {kyle}}; !reflect.DeepEqual(want, groups) {
+                t.Errorf("Map() = %v, want %v", groups, want)
+        }
+}
@@ -0,0 +1,167 @@
+package collection
+
+import (
+        "fmt"
+        "reflect"
+        "sort"
+)
+
+// GroupLevel describes one level of a hierarchical group-by: how the key of an item is selected at that level,
+// and, optionally, how the groups of the level are sorted and aggregated.
+type GroupLevel[T any] struct {
+        Key       func(T) any        // Key selects the key of the item at this level. It must return comparable values.
+        Order     func(a, b any) int // Order sorts the groups of this level by their keys. If nil, they keep the order their keys were first seen.
+        Aggregate func([]T) any      // Aggregate computes the Value of every group of this level. If nil, the Value is nil.
+}
+
+// Level creates a GroupLevel from a typed key selector.
+func Level[T any, K comparable](key func(T) K) GroupLevel[T] {
+        return GroupLevel[T]{Key: func(item T) any { return key(item) }}
+}
+
+// Sorted returns a copy of the level whose groups are sorted by the natural order of their keys, like SortedKeys does.
+func (l GroupLevel[T]) Sorted() GroupLevel[T] {
+        l.Order = func(a, b any) int {
+                return compareValues(reflect.ValueOf(a), reflect.ValueOf(b))
+        }
+        return l
+}
+
+// Aggregated returns a copy of the level whose groups hold in Value the result of the aggregate function on their items.
+func (l GroupLevel[T]) Aggregated(aggregate func([]T) any) GroupLevel[T] {
+        l.Aggregate = aggregate
+        return l
+}
+
+// GroupNode is a group of a hierarchical group-by. The root node holds every item of the source and has no key;
+// every other node holds the items that share its key and the keys of its ancestors.
+type GroupNode[T any] struct {
+        Key      any             // Key is the key of the group at its level, nil for the root.
+        Items    []T             // Items are the items of the group, in the order of the source.
+        Value    any             // Value is the aggregated value of the group, if its level sets Aggregate.
+        Children []*GroupNode[T] // Children are the groups of the next level, empty for the last level.
+}
+
+// Find returns the descendant reached following the keys, one per level, and whether it exists.
+// Without keys, it returns the node itself.
+func (n *GroupNode[T]) Find(keys ...any) (*GroupNode[T], bool) {
+        node := n
+        for _, key := range keys {
+                next := (*GroupNode[T])(nil)
+                for _, child := range node.Children {
+                        if child.Key == key {
+                                next = child
+                                break
+                        }
+                }
+                if next == nil {
+                        return nil, false
+                }
+                node = next
+        }
+        return node, true
+}
+
+// GroupByTree groups the elements of the source by several keys, one per level, building a tree of groups.
+// If the source is a map, T must be Touple or Entry.
+// Parameters:
+//   - source: the collection of elements to be grouped.
+//   - levels: the levels of the tree, from the outermost to the innermost.
+//   - opts: options that customize the iteration, like SortedKeys.
+//
+// Returns:
+//   - *GroupNode[T]: the root of the tree, which holds every item of the source.
+//   - error: an error if the source is not of the appropriate type, if a key selector panics or if it returns a key
+//     that can not be compared, like a slice.
+func GroupByTree[T any](source any, levels []GroupLevel[T], opts ...Option) (*GroupNode[T], error) {
+        items := []T{}
+        paths := [][]any{}
+        var action ActionErr[T] = func(index int, item T) error {
+                path := make([]any, len(levels))
+                for depth, level := range levels {
+                        key := level.Key(item)
+                        if key != nil && !reflect.ValueOf(key).Comparable() {
+                                return fmt.Errorf("the key %v of type %T at the level %d can not be compared", key, key, depth)
+                        }
+                        path[depth] = key
+                }
+                items = append(items, item)
+                paths = append(paths, path)
+                return nil
+        }
+        if err := run(action, source, newConfig(opts)); err != nil {
+                return nil, err
+        }
+
+        root := &GroupNode[T]{Items: items}
+        buildGroups(root, paths, levels, 0)
+        return root, nil
+}
+
+// buildGroups splits the items of the node by their key at the given depth, and recursively builds the groups of the
+// next levels. The paths hold the keys of every item of the node, indexed by level.
+func buildGroups[T any](node *GroupNode[T], paths [][]any, levels []GroupLevel[T], depth int) {
+        if depth == len(levels) {
+                return
+        }
+        level := levels[depth]
+        children := map[any]*GroupNode[T]{}
+        childPaths := map[any][][]any{}
+        for index, item := range node.Items {
+                key := paths[index][depth]
+                child, found := children[key]
+                if !found {
+                        child = &GroupNode[T]{Key: key}
+                        children[key] = child
+                        node.Children = append(node.Children, child)
+                }
+                child.Items = append(child.Items, item)
+                childPaths[key] = append(childPaths[key], paths[index])
+        }
+        if level.Order != nil {
+                sort.SliceStable(node.Children, func(i, j int) bool {
+                        return level.Order(node.Children[i].Key, node.Children[j].Key) < 0
+                })
+        }
+        for _, child := range node.Children {
+                if level.Aggregate != nil {
+                        child.Value = level.Aggregate(child.Items)
+                }
+                buildGroups(child, childPaths[child.Key], levels, depth+1)
+        }
+}
+
+// GroupBy2 groups the elements of the source by two keys, producing a nested typed map.
+// If the source is a map, T must be Touple or Entry.
+func GroupBy2[T any, K1, K2 comparable](key1 func(T) K1, key2 func(T) K2, source any, opts ...Option) (map[K1]map[K2][]T, error) {
+        groups := map[K1]map[K2][]T{}
+        var action ActionErr[T] = func(index int, item T) error {
+                outer, inner := key1(item), key2(item)
+                if groups[outer] == nil {
+                        groups[outer] = map[K2][]T{}
+                }
+                groups[outer][inner] = append(groups[outer][inner], item)
+                return nil
+        }
+        err := run(action, source, newConfig(opts))
+        return groups, err
+}
+
+// GroupBy3 groups the elements of the source by three keys, producing a nested typed map.
+// If the source is a map, T must be Touple or Entry.
+func GroupBy3[T any, K1, K2, K3 comparable](key1 func(T) K1, key2 func(T) K2, key3 func(T) K3, source any, opts ...Option) (map[K1]map[K2]map[K3][]T, error) {
+        groups := map[K1]map[K2]map[K3][]T{}
+        var action ActionErr[T] = func(index int, item T) error {
+                first, second, third := key1(item), key2(item), key3(item)
+                if groups[first] == nil {
+                        groups[first] = map[K2]map[K3][]T{}
+                }
+                if groups[first][second] == nil {
+                        groups[first][second] = map[K3][]T{}
+                }
+                groups[first][second][third] = append(groups[first][second][third], item)
+                return nil
+        }
+        err := run(action, source, newConfig(opts))
+        return groups, err
+}
@@ -0,0 +1,95 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "strings"
+        "testing"
+)
+
+type sale struct {
+        region string
+        team   string
+        month  int
+        amount int
+}
+
+func generateSales() []sale {
+        return []sale{
+                {"north", "red", 2, 10},
+                {"south", "blue", 1, 20},
+                {"north", "blue", 1, 30},
+                {"north", "red", 1, 40},
+        }
+}
+
+func TestGroupByTree(t *testing.T) {
+        total := func(sales []sale) any {
+                sum := 0
+                for _, s := range sales {
+                        sum += s.amount
+                }
+                return sum
+        }
+        root, err := GroupByTree(generateSales(), []GroupLevel[sale]{
+                Level(func(s sale) string { return s.region }).Aggregated(total),
+                Level(func(s sale) string { return s.team }).Sorted(),
+                Level(func(s sale) int { return s.month }).Sorted().Aggregated(total),
+        })
+        if err != nil {
+                t.Fatalf("GroupByTree failed: %v", err)
+        }
+        if len(root.Items) != 4 || len(root.Children) != 2 || root.Children[0].Key != "north" {
+                t.Fatalf("GroupByTree() = %v, want the regions north and south in first-seen order", root.Children)
+        }
+        if north, _ := root.Find("north"); north.Value != 80 {
+                t.Errorf("GroupByTree() north = %v, want 80", north.Value)
+        }
+        north, _ := root.Find("north")
+        if teams := []any{north.Children[0].Key, north.Children[1].Key}; !reflect.DeepEqual([]any{"blue", "red"}, teams) {
+                t.Errorf("GroupByTree() teams = %v, want [blue red]", teams)
+        }
+        red, found := root.Find("north", "red")
+        if !found || red.Children[0].Key != 1 || red.Children[0].Value != 40 || red.Children[1].Value != 10 {
+                t.Errorf("GroupByTree() months = %v, want 1 before 2", red.Children)
+        }
+        if _, found := root.Find("north", "green"); found {
+                t.Errorf("Find() should not find a missing key")
+        }
+
+        empty, err := GroupByTree([]sale{}, []GroupLevel[sale]{Level(func(s sale) string { return s.region })})
+        if err != nil || len(empty.Children) != 0 {
+                t.Errorf("GroupByTree() = %v, %v, want an empty tree", empty, err)
+        }
+        _, err = GroupByTree(generateSales(), []GroupLevel[sale]{Level(func(s sale) string { panic(errOdd) })})
+        if !errors.Is(err, errOdd) {
+                t.Errorf("GroupByTree() = %v, want errOdd", err)
+        }
+        unhashable := GroupLevel[sale]{Key: func(s sale) any { return []int{s.month} }}
+        if _, err = GroupByTree(generateSales(), []GroupLevel[sale]{unhashable}); err == nil || !strings.Contains(err.Error(), "at index 0") {
+                t.Errorf("GroupByTree() = %v, want the key at index 0 rejected", err)
+        }
+
+        byKey, err := GroupByTree(map[string]int{"b": 2, "a": 1}, []GroupLevel[Touple]{
+                {Key: func(tu Touple) any { return tu.Value.(int) > 0 }},
+        }, SortedKeys())
+        if positives, _ := byKey.Find(true); err != nil || positives.Items[0].Key != "a" {
+                t.Errorf("GroupByTree() = %v, %v, want the entries in the order of their keys", byKey, err)
+        }
+}
+
+func TestGroupByNested(t *testing.T) {
+        region := func(s sale) string { return s.region }
+        team := func(s sale) string { return s.team }
+        month := func(s sale) int { return s.month }
+        sales := generateSales()
+
+        byTeam, err := GroupBy2(region, team, sales)
+        if want := []sale{sales[0], sales[3]}; err != nil || !reflect.DeepEqual(want, byTeam["north"]["red"]) {
+                t.Errorf("GroupBy2() = %v, %v, want %v", byTeam["north"]["red"], err, want)
+        }
+        byMonth, err := GroupBy3(region, team, month, sales)
+        if want := []sale{sales[2]}; err != nil || !reflect.DeepEqual(want, byMonth["north"]["blue"][1]) {
+                t.Errorf("GroupBy3() = %v, %v, want %v", byMonth["north"]["blue"][1], err, want)
+        }
+}
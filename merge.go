@@ -0,0 +1,88 @@
+package collection
+
+import (
+        "fmt"
+        "reflect"
+)
+
+// MergeFunc decides the value stored under a key of a map destination that already holds a value.
+// It receives the key, the value already stored and the incoming value, and returns the value to store,
+// or an error that is reported like any other failure of the item being processed.
+type MergeFunc func(key, existing, incoming any) (any, error)
+
+// WithMerge sets the policy used by Map, GroupBy and Zip when a key is already in the map destination.
+// Without this option, Map and GroupBy append the incoming value when both values are slices and overwrite
+// the existing value otherwise, while Zip always overwrites it. When the values of the map are slices, a single
+// incoming element is wrapped into a slice before merging it.
+func WithMerge(merge MergeFunc) Option {
+        return func(cfg *config) {
+                cfg.merge = merge
+        }
+}
+
+// mergeFunc returns the merge function set with WithMerge, or the fallback when there is none.
+func (cfg config) mergeFunc(fallback MergeFunc) MergeFunc {
+        if cfg.merge != nil {
+                return cfg.merge
+        }
+        return fallback
+}
+
+// MergeOverwrite replaces the existing value with the incoming one.
+func MergeOverwrite(key, existing, incoming any) (any, error) {
+        return incoming, nil
+}
+
+// MergeKeepFirst keeps the existing value and discards the incoming one.
+func MergeKeepFirst(key, existing, incoming any) (any, error) {
+        return existing, nil
+}
+
+// MergeAppend appends the incoming value to the existing one, which must be a slice. The incoming value can be
+// a slice of the same type, whose elements are appended, or a single element.
+func MergeAppend(key, existing, incoming any) (any, error) {
+        existingValue := reflect.ValueOf(existing)
+        incomingValue := reflect.ValueOf(incoming)
+        if existingValue.Kind() != reflect.Slice {
+                return nil, fmt.Errorf("can not append to the value of the key %v: %T is not a slice", key, existing)
+        }
+        if !incomingValue.IsValid() {
+                return nil, fmt.Errorf("can not append a nil value to the value of the key %v", key)
+        }
+        if incomingValue.Type() == existingValue.Type() {
+                return reflect.AppendSlice(existingValue, incomingValue).Interface(), nil
+        }
+        if !incomingValue.Type().AssignableTo(existingValue.Type().Elem()) {
+                return nil, fmt.Errorf("can not append %T to the value of the key %v of type %T", incoming, key, existing)
+        }
+        return reflect.Append(existingValue, incomingValue).Interface(), nil
+}
+
+// MergeErrorOnDuplicate rejects the incoming value with an error that wraps ErrDuplicateKey.
+func MergeErrorOnDuplicate(key, existing, incoming any) (any, error) {
+        return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, key)
+}
+
+// MergeWith builds a MergeFunc from a typed function. Keys or values of other types are rejected with an error.
+func MergeWith[K, V any](merge func(key K, existing, incoming V) (V, error)) MergeFunc {
+        return func(key, existing, incoming any) (any, error) {
+                typedKey, okKey := key.(K)
+                typedExisting, okExisting := existing.(V)
+                typedIncoming, okIncoming := incoming.(V)
+                if !okKey || !okExisting || !okIncoming {
+                        return nil, fmt.Errorf("can not merge the values %v and %v of the key %v", existing, incoming, key)
+                }
+                return merge(typedKey, typedExisting, typedIncoming)
+        }
+}
+
+// defaultMerge is the policy applied to map destinations when no other is set: when both values are slices the
+// incoming value is appended to the existing one, otherwise the incoming value replaces the existing one.
+func defaultMerge(key, existing, incoming any) (any, error) {
+        existingValue := reflect.ValueOf(existing)
+        incomingValue := reflect.ValueOf(incoming)
+        if existingValue.Kind() == reflect.Slice && incomingValue.Kind() == reflect.Slice {
+                return reflect.AppendSlice(existingValue, incomingValue).Interface(), nil
+        }
+        return incoming, nil
+}
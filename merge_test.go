@@ -0,0 +1,118 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "testing"
+)
+
+var mapperSurnameToName Mapper[testUser] = func(tu testUser) any {
+        return Touple{tu.secondName, tu.name}
+}
+
+func TestMergeStrategies(t *testing.T) {
+        tests := []struct {
+                name  string
+                merge MergeFunc
+                want  map[string]string
+        }{
+                {"Default overwrites", nil, map[string]string{"Connor": "Sarah", "Risk": "Kyle"}},
+                {"Overwrite", MergeOverwrite, map[string]string{"Connor": "Sarah", "Risk": "Kyle"}},
+                {"Keep first", MergeKeepFirst, map[string]string{"Connor": "John", "Risk": "Kyle"}},
+                {"Custom", MergeWith(func(key string, existing, incoming string) (string, error) {
+                        return existing + "&" + incoming, nil
+                }), map[string]string{"Connor": "John&Sarah", "Risk": "Kyle"}},
+        }
+        for _, tt := range tests {
+                t.Run(tt.name, func(t *testing.T) {
+                        got := map[string]string{}
+                        if err := Map(mapperSurnameToName, generateTestCaseList(), got, WithMerge(tt.merge)); err != nil {
+                                t.Fatalf("Map failed: %v", err)
+                        }
+                        if !reflect.DeepEqual(tt.want, got) {
+                                t.Errorf("Map() = %v, want %v", got, tt.want)
+                        }
+                })
+        }
+}
+
+func TestMergeAppend(t *testing.T) {
+        got := map[string][]string{}
+        if err := Map(mapperSurnameToName, generateTestCaseList(), got, WithMerge(MergeAppend)); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        if want := map[string][]string{"Connor": {"John", "Sarah"}, "Risk": {"Kyle"}}; !reflect.DeepEqual(want, got) {
+                t.Errorf("Map() = %v, want %v", got, want)
+        }
+}
+
+func TestMergeErrorOnDuplicate(t *testing.T) {
+        err := Map(mapperSurnameToName, generateTestCaseList(), map[string]string{}, WithMerge(MergeErrorOnDuplicate))
+        if !errors.Is(err, ErrDuplicateKey) {
+                t.Fatalf("Map() = %v, want %v", err, ErrDuplicateKey)
+        }
+        if want := "error processing item {Sarah Connor [] 43 false} at index 1: duplicate key: Connor"; err.Error() != want {
+                t.Errorf("Map() = %q, want %q", err.Error(), want)
+        }
+
+        groups := NewOrderedMap[string, []testUser]()
+        if err := GroupBy(keySelectorBySex, generateTestCaseList(), groups, WithMerge(MergeErrorOnDuplicate)); !errors.Is(err, ErrDuplicateKey) {
+                t.Errorf("GroupBy() = %v, want %v", err, ErrDuplicateKey)
+        }
+
+        zipped := map[string]int{}
+        builder := Zip([]string{"a", "b", "a"}, []int{1, 2, 3}, zipped, WithMerge(MergeErrorOnDuplicate))
+        if !errors.Is(builder.Error(), ErrDuplicateKey) {
+                t.Errorf("Zip() = %v, want %v", builder.Error(), ErrDuplicateKey)
+        }
+        if want := "error processing item a at index 2: duplicate key: a"; builder.Error().Error() != want {
+                t.Errorf("Zip() = %q, want %q", builder.Error().Error(), want)
+        }
+}
+
+func TestMergeScalarsIntoSlices(t *testing.T) {
+        var toTouple Mapper[int] = func(n int) any {
+                return Touple{"k", n}
+        }
+        got := map[string][]int{}
+        if err := Map(toTouple, []int{1, 2}, got); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        if want := map[string][]int{"k": {1, 2}}; !reflect.DeepEqual(want, got) {
+                t.Errorf("Map() = %v, want %v", got, want)
+        }
+
+        ordered := NewOrderedMap[string, []int]()
+        if err := Map(toTouple, []int{1, 2}, ordered); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        if values, _ := ordered.Get("k"); !reflect.DeepEqual([]int{1, 2}, values) {
+                t.Errorf("Map() = %v, want [1 2]", values)
+        }
+
+        var panicErr *PanicError
+        if err := Map(toTouple, []int{1}, map[string]string{}); err == nil || errors.As(err, &panicErr) {
+                t.Errorf("Map() = %v, want an error that is not a panic", err)
+        }
+        if err := Map(toTouple, []int{1}, NewOrderedMap[string, string]()); err == nil || errors.As(err, &panicErr) {
+                t.Errorf("Map() = %v, want an error that is not a panic", err)
+        }
+}
+
+func TestMergeOfWrongType(t *testing.T) {
+        toText := func(key, existing, incoming any) (any, error) {
+                return "text", nil
+        }
+        builder := Zip([]string{"a", "a"}, []int{1, 2}, map[string]int{}, WithMerge(toText))
+        if builder.Error() == nil || builder.Index() != 1 {
+                t.Errorf("Zip() = %v, want the failure at index 1", builder.Error())
+        }
+
+        ordered := NewOrderedMap[string, int]()
+        if err := Map(func(n int) any { return Touple{"a", n} }, []int{1, 2}, ordered, WithMerge(toText)); err == nil {
+                t.Errorf("Map() should fail when the merge function returns a value of another type")
+        }
+        if value, _ := ordered.Get("a"); value != 1 {
+                t.Errorf("Map() = %v, want the first value kept", value)
+        }
+}
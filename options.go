@@ -0,0 +1,158 @@
+package collection
+
+import (
+        "cmp"
+        "context"
+        "errors"
+        "fmt"
+        "reflect"
+        "sort"
+)
+
+// Option customizes the way an operation iterates its source and reports its failures.
+type Option func(*config)
+
+// config holds the settings collected from the options passed to an operation.
+type config struct {
+        continueOnError bool
+        keyOrder        func(a, b reflect.Value) int
+        merge           MergeFunc
+        ctx             context.Context
+        lengthPolicy    LengthPolicy
+}
+
+// newConfig applies the options over the default settings.
+func newConfig(opts []Option) config {
+        cfg := config{}
+        for _, opt := range opts {
+                if opt != nil {
+                        opt(&cfg)
+                }
+        }
+        return cfg
+}
+
+// ContinueOnError makes the operation process every element of the source even when some of them fail.
+// The successful results are stored in the destination and the failures are returned together as an *AggregateError.
+func ContinueOnError() Option {
+        return func(cfg *config) {
+                cfg.continueOnError = true
+        }
+}
+
+// SortedKeys makes the operations iterate the map sources in the natural order of their keys, so the order of the
+// elements and the index passed to the actions are the same on every run.
+// Numbers, strings and booleans are compared by value; any other key is compared by its fmt representation.
+func SortedKeys() Option {
+        return func(cfg *config) {
+                cfg.keyOrder = compareValues
+        }
+}
+
+// WithKeyComparator makes the operations iterate the map sources in the order set by the comparator on their keys,
+// so the order of the elements and the index passed to the actions are the same on every run.
+// Keys that are not of type K are compared like SortedKeys does.
+func WithKeyComparator[K any](comparator Comparator[K]) Option {
+        return func(cfg *config) {
+                cfg.keyOrder = func(a, b reflect.Value) int {
+                        keyA, okA := a.Interface().(K)
+                        keyB, okB := b.Interface().(K)
+                        if !okA || !okB {
+                                return compareValues(a, b)
+                        }
+                        return comparator(keyA, keyB)
+                }
+        }
+}
+
+// WithContext makes the operation stop as soon as the context is done. The context is checked between elements, and
+// while waiting to receive from a channel source or to send into a channel destination. When the operation is
+// cancelled, the error returned wraps the error of the context and tells the index of the first element not processed.
+func WithContext(ctx context.Context) Option {
+        return func(cfg *config) {
+                cfg.ctx = ctx
+        }
+}
+
+// context returns the context set with WithContext, or a context that is never done when there is none.
+func (cfg config) context() context.Context {
+        if cfg.ctx != nil {
+                return cfg.ctx
+        }
+        return context.Background()
+}
+
+// interrupted returns the error reported when the context is done before every element is processed,
+// or nil if the context is not done. The index is the position of the first element not processed.
+func (cfg config) interrupted(index int) error {
+        if err := cfg.context().Err(); err != nil {
+                return fmt.Errorf("operation cancelled at index %d: %w", index, err)
+        }
+        return nil
+}
+
+// sortKeys sorts the keys of a map source following the configured order. Without order, the keys are returned as is.
+func (cfg config) sortKeys(keys []reflect.Value) []reflect.Value {
+        if cfg.keyOrder != nil {
+                sort.SliceStable(keys, func(i, j int) bool {
+                        return cfg.keyOrder(keys[i], keys[j]) < 0
+                })
+        }
+        return keys
+}
+
+// compareValues compares two values of the same kind by their natural order.
+func compareValues(a, b reflect.Value) int {
+        if a.Kind() == b.Kind() {
+                switch a.Kind() {
+                case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+                        return cmp.Compare(a.Int(), b.Int())
+                case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+                        return cmp.Compare(a.Uint(), b.Uint())
+                case reflect.Float32, reflect.Float64:
+                        return cmp.Compare(a.Float(), b.Float())
+                case reflect.String:
+                        return cmp.Compare(a.String(), b.String())
+                case reflect.Bool:
+                        return cmp.Compare(boolToInt(a.Bool()), boolToInt(b.Bool()))
+                }
+        }
+        return cmp.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+}
+
+func boolToInt(value bool) int {
+        if value {
+                return 1
+        }
+        return 0
+}
+
+// run validates and iterates the source applying the action, and reports the failures according to the configuration.
+func run[K any](action ActionErr[K], src any, cfg config) error {
+        if err := validateSource[K](src); err != nil {
+                return err
+        }
+        failures, interrupted := iterate(action, src, cfg)
+        return report(failures, interrupted, cfg)
+}
+
+// report converts the failures of an operation into the error returned to the caller.
+// By default only the first failure is reported, decorated with its item and index;
+// with ContinueOnError every failure is collected into an *AggregateError.
+// If the operation was interrupted by its context and no failure stopped it before, the interruption is reported too.
+func report[K any](failures []*BuilderError[K], interrupted error, cfg config) error {
+        if len(failures) == 0 {
+                return interrupted
+        }
+        if !cfg.continueOnError {
+                return formatError(failures[0])
+        }
+        itemErrors := make([]*ItemError, len(failures))
+        for index, failure := range failures {
+                itemErrors[index] = &ItemError{Index: failure.index, Item: failure.item, Err: failure.err}
+        }
+        if interrupted != nil {
+                return errors.Join(&AggregateError{Failures: itemErrors}, interrupted)
+        }
+        return &AggregateError{Failures: itemErrors}
+}
@@ -0,0 +1,70 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "strings"
+        "testing"
+)
+
+func TestSortedKeys(t *testing.T) {
+        for range 5 {
+                got := []string{}
+                var action Action[Touple] = func(index int, tu Touple) {
+                        got = append(got, tu.Key.(string))
+                }
+                if err := ForEach(action, generateTestCaseMap(), SortedKeys()); err != nil {
+                        t.Fatalf("ForEach failed: %v", err)
+                }
+                if want := []string{"John", "Kyle", "Sarah"}; !reflect.DeepEqual(want, got) {
+                        t.Fatalf("ForEach() = %v, want %v", got, want)
+                }
+        }
+
+        got := []int{}
+        if err := Map(func(tu Touple) any { return tu.Key }, map[int]bool{10: true, 2: true, 33: false}, &got, SortedKeys()); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        if want := []int{2, 10, 33}; !reflect.DeepEqual(want, got) {
+                t.Errorf("Map() = %v, want %v", got, want)
+        }
+}
+
+func TestWithKeyComparator(t *testing.T) {
+        descending := func(a, b string) int {
+                return strings.Compare(b, a)
+        }
+        var failOnKyle Action[Touple] = func(index int, tu Touple) {
+                if tu.Key == "Kyle" {
+                        panic(errOdd)
+                }
+        }
+        err := ForEach(failOnKyle, generateTestCaseMap(), WithKeyComparator(descending))
+        if err == nil || !strings.Contains(err.Error(), "at index 1") {
+                t.Errorf("ForEach() = %v, want the failure at index 1", err)
+        }
+
+        var aggregate *AggregateError
+        err = ForEach(failOnKyle, generateTestCaseMap(), WithKeyComparator(descending), ContinueOnError())
+        if !errors.As(err, &aggregate) || aggregate.Failures[0].Index != 1 {
+                t.Errorf("ForEach() = %v, want the failure at index 1", err)
+        }
+
+        names := []string{}
+        for tu := range Values[Touple](generateTestCaseMap(), WithKeyComparator(descending)) {
+                names = append(names, tu.Key.(string))
+        }
+        if want := []string{"Sarah", "Kyle", "John"}; !reflect.DeepEqual(want, names) {
+                t.Errorf("Values() = %v, want %v", names, want)
+        }
+}
+
+func TestParallelWithSortedKeys(t *testing.T) {
+        got := []string{}
+        if err := ParallelMap(4, mapperToNamesFromMap, generateTestCaseMap(), &got, SortedKeys()); err != nil {
+                t.Fatalf("ParallelMap failed: %v", err)
+        }
+        if want := []string{"John Connor", "Kyle Risk", "Sarah Connor"}; !reflect.DeepEqual(want, got) {
+                t.Errorf("ParallelMap() = %v, want %v", got, want)
+        }
+}
@@ -0,0 +1,194 @@
+package collection
+
+import (
+        "bytes"
+        "container/list"
+        "encoding/json"
+        "iter"
+        "reflect"
+)
+
+// OrderedMap is a map that remembers the order in which its keys were inserted.
+// Iterating it, or using it as source of ForEach, Map, Filter and the rest of operations (which receive its entries
+// as Touple or as Entry), follows that order,
+// and the index passed to the actions is the position of the entry. Used as destination, for example of GroupBy,
+// the keys keep the order in which they were first seen.
+// Every operation on a single key, including Delete, MoveToFront and MoveToBack, runs in O(1).
+// The zero value is an empty map ready to use.
+type OrderedMap[K comparable, V any] struct {
+        index map[K]*list.Element
+        order *list.List
+}
+
+// orderedEntry is the element stored in the list that keeps the order of the keys.
+type orderedEntry[K comparable, V any] struct {
+        key   K
+        value V
+}
+
+// NewOrderedMap creates a new empty OrderedMap.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+        return &OrderedMap[K, V]{index: map[K]*list.Element{}, order: list.New()}
+}
+
+// Set stores the value under the key. A new key is added at the back; an existing key keeps its position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+        if m.order == nil {
+                m.index, m.order = map[K]*list.Element{}, list.New()
+        }
+        if element, found := m.index[key]; found {
+                element.Value.(*orderedEntry[K, V]).value = value
+                return
+        }
+        m.index[key] = m.order.PushBack(&orderedEntry[K, V]{key, value})
+}
+
+// Get returns the value stored under the key, and whether the key was found.
+func (m *OrderedMap[K, V]) Get(key K) (value V, found bool) {
+        element, found := m.index[key]
+        if found {
+                value = element.Value.(*orderedEntry[K, V]).value
+        }
+        return
+}
+
+// Has reports whether the key is in the map.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+        _, found := m.index[key]
+        return found
+}
+
+// Delete removes the key from the map, and reports whether it was found.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+        element, found := m.index[key]
+        if found {
+                m.order.Remove(element)
+                delete(m.index, key)
+        }
+        return found
+}
+
+// MoveToFront moves the key to the first position, and reports whether it was found.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+        element, found := m.index[key]
+        if found {
+                m.order.MoveToFront(element)
+        }
+        return found
+}
+
+// MoveToBack moves the key to the last position, and reports whether it was found.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+        element, found := m.index[key]
+        if found {
+                m.order.MoveToBack(element)
+        }
+        return found
+}
+
+// Len returns the number of entries of the map.
+func (m *OrderedMap[K, V]) Len() int {
+        return len(m.index)
+}
+
+// Keys returns the keys of the map in order.
+func (m *OrderedMap[K, V]) Keys() []K {
+        keys := make([]K, 0, m.Len())
+        for key := range m.All() {
+                keys = append(keys, key)
+        }
+        return keys
+}
+
+// Values returns the values of the map in the order of their keys.
+func (m *OrderedMap[K, V]) Values() []V {
+        values := make([]V, 0, m.Len())
+        for _, value := range m.All() {
+                values = append(values, value)
+        }
+        return values
+}
+
+// All returns a sequence over the entries of the map in order.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+        return func(yield func(K, V) bool) {
+                if m.order == nil {
+                        return
+                }
+                for element := m.order.Front(); element != nil; element = element.Next() {
+                        entry := element.Value.(*orderedEntry[K, V])
+                        if !yield(entry.key, entry.value) {
+                                return
+                        }
+                }
+        }
+}
+
+// MarshalJSON encodes the map as a JSON object whose members follow the order of the keys.
+// Like encoding/json does with maps, keys that are not encoded as JSON strings, like numbers, are quoted.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+        var buffer bytes.Buffer
+        buffer.WriteByte('{')
+        count := 0
+        for key, value := range m.All() {
+                if count > 0 {
+                        buffer.WriteByte(',')
+                }
+                count++
+                encodedKey, err := json.Marshal(key)
+                if err != nil {
+                        return nil, err
+                }
+                if encodedKey[0] != '"' {
+                        encodedKey, _ = json.Marshal(string(encodedKey))
+                }
+                encodedValue, err := json.Marshal(value)
+                if err != nil {
+                        return nil, err
+                }
+                buffer.Write(encodedKey)
+                buffer.WriteByte(':')
+                buffer.Write(encodedValue)
+        }
+        buffer.WriteByte('}')
+        return buffer.Bytes(), nil
+}
+
+func (m *OrderedMap[K, V]) entries() iter.Seq2[any, any] {
+        return func(yield func(any, any) bool) {
+                for key, value := range m.All() {
+                        if !yield(key, value) {
+                                return
+                        }
+                }
+        }
+}
+
+func (m *OrderedMap[K, V]) entryTypes() (reflect.Type, reflect.Type) {
+        return reflect.TypeFor[K](), reflect.TypeFor[V]()
+}
+
+// put stores a Touple or an Entry following the same rules applied to the maps used as destination: the key and
+// the value are converted like store does, and a key already stored is merged with the merge function.
+func (m *OrderedMap[K, V]) put(data any, merge MergeFunc) error {
+        rawKey, rawValue := data.(keyValue).pair()
+        key, err := typed[K](convertTo(rawKey, reflect.TypeFor[K]()))
+        if err != nil {
+                return err
+        }
+        value, err := typed[V](mapValue(rawValue, reflect.TypeFor[V]()))
+        if err != nil {
+                return err
+        }
+        if existing, found := m.Get(key); found {
+                merged, err := merge(key, existing, value)
+                if err != nil {
+                        return err
+                }
+                if value, err = typed[V](mapValue(merged, reflect.TypeFor[V]())); err != nil {
+                        return err
+                }
+        }
+        m.Set(key, value)
+        return nil
+}
@@ -0,0 +1,82 @@
+package collection
+
+import (
+        "encoding/json"
+        "reflect"
+        "testing"
+)
+
+func TestOrderedMap(t *testing.T) {
+        m := NewOrderedMap[string, int]()
+        m.Set("c", 3)
+        m.Set("a", 1)
+        m.Set("b", 2)
+        m.Set("c", 30)
+
+        if want := []string{"c", "a", "b"}; !reflect.DeepEqual(want, m.Keys()) {
+                t.Errorf("Keys() = %v, want %v", m.Keys(), want)
+        }
+        if value, found := m.Get("c"); !found || value != 30 {
+                t.Errorf("Get() = %v, %v, want 30", value, found)
+        }
+
+        m.MoveToBack("c")
+        m.MoveToFront("b")
+        m.Delete("a")
+        if m.Delete("z") || m.MoveToFront("z") || m.Has("a") {
+                t.Errorf("missing keys should be reported as not found")
+        }
+        if want := []int{2, 30}; !reflect.DeepEqual(want, m.Values()) {
+                t.Errorf("Values() = %v, want %v", m.Values(), want)
+        }
+
+        encoded, err := json.Marshal(m)
+        if err != nil {
+                t.Fatalf("MarshalJSON failed: %v", err)
+        }
+        if want := `{"b":2,"c":30}`; string(encoded) != want {
+                t.Errorf("MarshalJSON() = %s, want %s", encoded, want)
+        }
+
+        numbers := NewOrderedMap[int, string]()
+        numbers.Set(2, "two")
+        numbers.Set(1, "one")
+        if encoded, _ := json.Marshal(numbers); string(encoded) != `{"2":"two","1":"one"}` {
+                t.Errorf("MarshalJSON() = %s, want the keys quoted", encoded)
+        }
+}
+
+func TestOrderedMapAsSourceAndDestination(t *testing.T) {
+        groups := NewOrderedMap[string, []testUser]()
+        if err := GroupBy(keySelectorBySex, []testUser{sarah, john, kyle}, groups); err != nil {
+                t.Fatalf("GroupBy failed: %v", err)
+        }
+        if want := []string{"female", "male"}; !reflect.DeepEqual(want, groups.Keys()) {
+                t.Errorf("GroupBy() keys = %v, want %v", groups.Keys(), want)
+        }
+        if males, _ := groups.Get("male"); !reflect.DeepEqual([]testUser{john, kyle}, males) {
+                t.Errorf("GroupBy() = %v, want [john kyle]", males)
+        }
+
+        report := []string{}
+        var action Action[Touple] = func(index int, tu Touple) {
+                report = append(report, tu.Key.(string))
+        }
+        if err := ForEach(action, groups); err != nil {
+                t.Fatalf("ForEach failed: %v", err)
+        }
+        if want := []string{"female", "male"}; !reflect.DeepEqual(want, report) {
+                t.Errorf("ForEach() = %v, want %v", report, want)
+        }
+}
+
+func TestOrderedMapZeroValue(t *testing.T) {
+        var m OrderedMap[string, int]
+        if m.Len() != 0 || len(m.Keys()) != 0 || m.Has("a") {
+                t.Errorf("the zero value of OrderedMap should be empty")
+        }
+        m.Set("a", 1)
+        if value, _ := m.Get("a"); value != 1 {
+                t.Errorf("Get() = %v, want 1", value)
+        }
+}
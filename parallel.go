@@ -0,0 +1,160 @@
+package collection
+
+import (
+        "runtime"
+        "sync"
+        "sync/atomic"
+)
+
+// task is the unit of work executed by the workers of runParallel. It returns the value to be stored in the
+// destination and whether it must be stored at all.
+type task[K any] func(int, K) (any, bool)
+
+// runParallel applies the task to each element of the source using a pool of workers. Once every worker is done,
+// the results are stored in dest following the order of the source, so the output is the same as the sequential one.
+// When a task fails, no new items are dispatched, only the results previous to the failing item are stored and the
+// failure with the lowest index is returned. If the configuration asks to continue on error, every item is processed,
+// every successful result is stored and every failure is returned.
+// When the context of the configuration is done, no new items are dispatched and the interruption is returned apart.
+// If workers is less than 1, runtime.GOMAXPROCS(0) workers are used.
+func runParallel[K any](workers int, work task[K], src any, dest any, cfg config) ([]*BuilderError[K], error) {
+        if workers < 1 {
+                workers = runtime.GOMAXPROCS(0)
+        }
+
+        items := []any{}
+        for _, item := range elements[K](src, cfg) {
+                items = append(items, item)
+        }
+        results := make([]any, len(items))
+        kept := make([]bool, len(items))
+        failures := make([]*BuilderError[K], len(items))
+
+        var failed atomic.Bool
+        var wg sync.WaitGroup
+        indexes := make(chan int)
+        for range workers {
+                wg.Add(1)
+                go func() {
+                        defer wg.Done()
+                        var action Action[K] = func(index int, item K) {
+                                results[index], kept[index] = work(index, item)
+                        }
+                        for index := range indexes {
+                                if failures[index] = evaluate(infallible(action), index, items[index]); failures[index] != nil {
+                                        failed.Store(true)
+                                }
+                        }
+                }()
+        }
+        reached := 0
+        for index := range items {
+                if (failed.Load() && !cfg.continueOnError) || cfg.context().Err() != nil {
+                        break
+                }
+                indexes <- index
+                reached = index + 1
+        }
+        close(indexes)
+        wg.Wait()
+
+        var collect ActionErr[K] = func(index int, item K) error {
+                if kept[index] {
+                        return store(results[index], dest, cfg)
+                }
+                return nil
+        }
+        reported := []*BuilderError[K]{}
+        for index, item := range items {
+                if failures[index] == nil {
+                        failures[index] = evaluate(collect, index, item)
+                }
+                if failures[index] != nil {
+                        reported = append(reported, failures[index])
+                        if !cfg.continueOnError {
+                                break
+                        }
+                }
+        }
+        return reported, cfg.interrupted(reached)
+}
+
+// ParallelForEach applies the action function to each element in the source collection using a pool of workers.
+// The action is called concurrently, so it must be safe for concurrent use.
+// Parameters:
+//   - workers: the number of goroutines used. If it is less than 1, runtime.GOMAXPROCS(0) is used.
+//   - action: a function that takes an index and a value of type T and performs an action.
+//   - source: the collection of elements to iterate over.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - error: the error of the item with the lowest index that failed, decorated like the error returned by ForEach.
+func ParallelForEach[T any](workers int, action Action[T], source any, opts ...Option) (err error) {
+        if err = validateSource[T](source); err != nil {
+                return
+        }
+
+        var work task[T] = func(index int, item T) (any, bool) {
+                action(index, item)
+                return nil, false
+        }
+        cfg := newConfig(opts)
+        failures, interrupted := runParallel(workers, work, source, nil, cfg)
+        return report(failures, interrupted, cfg)
+}
+
+// ParallelFilter filters elements from the source using a pool of workers and stores the results in the destination,
+// in the same order a sequential Filter would.
+// The predicate is called concurrently, so it must be safe for concurrent use.
+// Parameters:
+//   - workers: the number of goroutines used. If it is less than 1, runtime.GOMAXPROCS(0) is used.
+//   - predicate: a function that takes a value of type T and returns a boolean indicating whether the value satisfies the condition.
+//   - source: the collection of elements to be filtered.
+//   - dest: the destination where the results will be stored. Must be a map or a pointer to a list.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - error: the error of the item with the lowest index that failed, decorated like the error returned by Filter.
+func ParallelFilter[T any](workers int, predicate Predicate[T], source any, dest any, opts ...Option) (err error) {
+        if err = validateSource[T](source); err != nil {
+                return
+        }
+        if err = validateDest(dest); err != nil {
+                return
+        }
+
+        var work task[T] = func(index int, item T) (any, bool) {
+                return item, predicate(item)
+        }
+        cfg := newConfig(opts)
+        failures, interrupted := runParallel(workers, work, source, dest, cfg)
+        return report(failures, interrupted, cfg)
+}
+
+// ParallelMap applies the mapper function to each element in the source using a pool of workers and stores the
+// results in the destination, in the same order a sequential Map would.
+// The mapper is called concurrently, so it must be safe for concurrent use.
+// Parameters:
+//   - workers: the number of goroutines used. If it is less than 1, runtime.GOMAXPROCS(0) is used.
+//   - mapper: a function that takes a value of type T and returns a transformed value.
+//   - source: the collection of elements to be mapped.
+//   - dest: the destination where the results will be stored. Must be a map or a pointer to a list.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - error: the error of the item with the lowest index that failed, decorated like the error returned by Map.
+func ParallelMap[T any](workers int, mapper Mapper[T], source any, dest any, opts ...Option) (err error) {
+        if err = validateSource[T](source); err != nil {
+                return
+        }
+        if err = validateDest(dest); err != nil {
+                return
+        }
+
+        var work task[T] = func(index int, item T) (any, bool) {
+                return mapper(item), true
+        }
+        cfg := newConfig(opts)
+        failures, interrupted := runParallel(workers, work, source, dest, cfg)
+        return report(failures, interrupted, cfg)
+}
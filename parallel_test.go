@@ -0,0 +1,93 @@
+package collection
+
+import (
+        "errors"
+        "fmt"
+        "reflect"
+        "strings"
+        "sync/atomic"
+        "testing"
+)
+
+func generateNumbers(size int) []int {
+        numbers := make([]int, size)
+        for index := range numbers {
+                numbers[index] = index
+        }
+        return numbers
+}
+
+func TestParallelMap(t *testing.T) {
+        numbers := generateNumbers(1000)
+        want := []int{}
+        Map(func(n int) any { return n * n }, numbers, &want)
+
+        got := []int{}
+        if err := ParallelMap(8, func(n int) any { return n * n }, numbers, &got); err != nil {
+                t.Fatalf("ParallelMap failed: %v", err)
+        }
+        if !reflect.DeepEqual(want, got) {
+                t.Errorf("ParallelMap() does not preserve the order of the source")
+        }
+}
+
+func TestParallelFilter(t *testing.T) {
+        got := []testUser{}
+        if err := ParallelFilter(0, isMale, generateTestCaseList(), &got); err != nil {
+                t.Fatalf("ParallelFilter failed: %v", err)
+        }
+        if want := []testUser{john, kyle}; !reflect.DeepEqual(want, got) {
+                t.Errorf("ParallelFilter() = %v, want %v", got, want)
+        }
+}
+
+func TestParallelForEach(t *testing.T) {
+        var sum atomic.Int64
+        var action Action[int] = func(index int, n int) {
+                sum.Add(int64(n))
+        }
+        if err := ParallelForEach(4, action, generateNumbers(100)); err != nil {
+                t.Fatalf("ParallelForEach failed: %v", err)
+        }
+        if sum.Load() != 4950 {
+                t.Errorf("ParallelForEach() sum = %d, want 4950", sum.Load())
+        }
+}
+
+func TestParallelError(t *testing.T) {
+        var mapperKO Mapper[int] = func(n int) any {
+                if n >= 10 {
+                        panic(fmt.Errorf("KO %d", n))
+                }
+                return n
+        }
+        got := []int{}
+        err := ParallelMap(4, mapperKO, generateNumbers(100), &got)
+        if err == nil {
+                t.Fatalf("ParallelMap() should fail")
+        }
+        if !strings.Contains(err.Error(), "at index 10") {
+                t.Errorf("ParallelMap() error = %v, want the failure at index 10", err)
+        }
+        if want := generateNumbers(10); !reflect.DeepEqual(want, got) {
+                t.Errorf("ParallelMap() = %v, want %v", got, want)
+        }
+}
+
+func TestParallelContinueOnError(t *testing.T) {
+        var mapperKO Mapper[int] = func(n int) any {
+                if n%3 == 0 {
+                        panic(errOdd)
+                }
+                return n
+        }
+        numbers := []int{}
+        err := ParallelMap(4, mapperKO, generateNumbers(10), &numbers, ContinueOnError())
+        var aggregate *AggregateError
+        if !errors.As(err, &aggregate) || len(aggregate.Failures) != 4 {
+                t.Errorf("ParallelMap() = %v, want 4 failures", err)
+        }
+        if want := []int{1, 2, 4, 5, 7, 8}; !reflect.DeepEqual(want, numbers) {
+                t.Errorf("ParallelMap() = %v, want %v", numbers, want)
+        }
+}
@@ -0,0 +1,78 @@
+package collection
+
+// Reducer is a function type that combines two values of type T into a single value of type T.
+type Reducer[T any] func(T, T) T
+
+// Folder is a function type that combines an accumulator of type A with a value of type T, returning the new accumulator.
+type Folder[A, T any] func(A, T) A
+
+// Reduce combines the elements of the source into a single value, using the first element as the initial value.
+// If the source is a map, T must be Touple or Entry.
+// Parameters:
+//   - reducer: a function that combines the value accumulated so far with the next element.
+//   - source: the collection of elements to be reduced.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - T: the combination of every element, or the value accumulated until the failure.
+//   - error: ErrEmptySource if the source has no elements, or the error captured while reducing, decorated with the item and the index.
+func Reduce[T any](reducer Reducer[T], source any, opts ...Option) (result T, err error) {
+        empty := true
+        var action ActionErr[T] = func(index int, item T) error {
+                if empty {
+                        result, empty = item, false
+                } else {
+                        result = reducer(result, item)
+                }
+                return nil
+        }
+        if err = run(action, source, newConfig(opts)); err == nil && empty {
+                err = ErrEmptySource
+        }
+        return
+}
+
+// Fold combines the elements of the source into an accumulator, whose type can be different from the type of the elements.
+// If the source is a map, T must be Touple or Entry.
+// Parameters:
+//   - folder: a function that combines the accumulator with the next element.
+//   - initial: the initial value of the accumulator, returned as is when the source is empty.
+//   - source: the collection of elements to be folded.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - A: the accumulator after folding every element, or the value accumulated until the failure.
+//   - error: the error captured while folding, decorated with the item and the index.
+func Fold[T, A any](folder Folder[A, T], initial A, source any, opts ...Option) (result A, err error) {
+        result = initial
+        var action ActionErr[T] = func(index int, item T) error {
+                result = folder(result, item)
+                return nil
+        }
+        err = run(action, source, newConfig(opts))
+        return
+}
+
+// Scan folds the elements of the source like Fold does, but returns every intermediate value of the accumulator:
+// the element i of the result is the accumulator after folding the element i of the source.
+// If the source is a map, T must be Touple or Entry.
+// Parameters:
+//   - folder: a function that combines the accumulator with the next element.
+//   - initial: the initial value of the accumulator, which is not part of the result.
+//   - source: the collection of elements to be scanned.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - []A: the running accumulation, up to the failure if any.
+//   - error: the error captured while scanning, decorated with the item and the index.
+func Scan[T, A any](folder Folder[A, T], initial A, source any, opts ...Option) (result []A, err error) {
+        result = []A{}
+        accumulator := initial
+        var action ActionErr[T] = func(index int, item T) error {
+                accumulator = folder(accumulator, item)
+                result = append(result, accumulator)
+                return nil
+        }
+        err = run(action, source, newConfig(opts))
+        return
+}
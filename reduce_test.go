@@ -0,0 +1,56 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "testing"
+)
+
+func sum(a, b int) int {
+        return a + b
+}
+
+func TestReduce(t *testing.T) {
+        got, err := Reduce(sum, []int{1, 2, 3, 4})
+        if err != nil || got != 10 {
+                t.Errorf("Reduce() = %v, %v, want 10", got, err)
+        }
+
+        if _, err := Reduce(sum, []int{}); !errors.Is(err, ErrEmptySource) {
+                t.Errorf("Reduce() = %v, want %v", err, ErrEmptySource)
+        }
+
+        var reducerKO Reducer[int] = func(a, b int) int {
+                return a / (b - 3)
+        }
+        if _, err := Reduce(reducerKO, []int{1, 2, 3}); err == nil {
+                t.Errorf("Reduce() should fail")
+        }
+}
+
+func TestFold(t *testing.T) {
+        var sumAges Folder[int, Touple] = func(total int, tu Touple) int {
+                return total + tu.Value.(testUser).age
+        }
+        got, err := Fold(sumAges, 0, generateTestCaseMap())
+        if err != nil || got != 96 {
+                t.Errorf("Fold() = %v, %v, want 96", got, err)
+        }
+
+        var names Folder[string, testUser] = func(acc string, tu testUser) string {
+                return acc + tu.name
+        }
+        if got, _ := Fold(names, ">", []testUser{}); got != ">" {
+                t.Errorf("Fold() = %v, want the initial value", got)
+        }
+}
+
+func TestScan(t *testing.T) {
+        got, err := Scan(sum, 0, []int{1, 2, 3, 4})
+        if err != nil {
+                t.Fatalf("Scan failed: %v", err)
+        }
+        if want := []int{1, 3, 6, 10}; !reflect.DeepEqual(want, got) {
+                t.Errorf("Scan() = %v, want %v", got, want)
+        }
+}
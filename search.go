@@ -0,0 +1,59 @@
+package collection
+
+import (
+        "slices"
+        "sort"
+)
+
+// BinarySearchBy searches the target in a slice sorted by the comparator, and returns the position where it is,
+// or where it would be inserted, and whether it was found. If there are several equal elements, the position
+// of the first one is returned.
+func BinarySearchBy[T any](comparator Comparator[T], sorted []T, target T) (int, bool) {
+        return slices.BinarySearchFunc(sorted, target, comparator)
+}
+
+// LowerBound returns the position of the first element of a slice sorted by the comparator that is not less than
+// the target, or the length of the slice if there is none.
+func LowerBound[T any](comparator Comparator[T], sorted []T, target T) int {
+        return sort.Search(len(sorted), func(index int) bool {
+                return comparator(sorted[index], target) >= 0
+        })
+}
+
+// UpperBound returns the position of the first element of a slice sorted by the comparator that is greater than
+// the target, or the length of the slice if there is none.
+func UpperBound[T any](comparator Comparator[T], sorted []T, target T) int {
+        return sort.Search(len(sorted), func(index int) bool {
+                return comparator(sorted[index], target) > 0
+        })
+}
+
+// EqualRange returns the positions that delimit the elements of a slice sorted by the comparator that are equal to
+// the target: sorted[first:last] holds all of them, and it is empty when there is none.
+func EqualRange[T any](comparator Comparator[T], sorted []T, target T) (first int, last int) {
+        return LowerBound(comparator, sorted, target), UpperBound(comparator, sorted, target)
+}
+
+// InsertSorted inserts the item into a slice sorted by the comparator, keeping it sorted, and returns the modified
+// slice, like append does. The item is inserted after the elements equal to it, so insertions are stable.
+func InsertSorted[T any](comparator Comparator[T], sorted []T, item T) []T {
+        return slices.Insert(sorted, UpperBound(comparator, sorted, item), item)
+}
+
+// MergeSorted merges two slices sorted by the comparator into a new sorted slice. The merge is stable: the elements
+// of first go before the equal elements of second.
+func MergeSorted[T any](comparator Comparator[T], first []T, second []T) []T {
+        merged := make([]T, 0, len(first)+len(second))
+        i, j := 0, 0
+        for i < len(first) && j < len(second) {
+                if comparator(second[j], first[i]) < 0 {
+                        merged = append(merged, second[j])
+                        j++
+                } else {
+                        merged = append(merged, first[i])
+                        i++
+                }
+        }
+        merged = append(merged, first[i:]...)
+        return append(merged, second[j:]...)
+}
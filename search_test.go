@@ -0,0 +1,62 @@
+package collection
+
+import (
+        "reflect"
+        "testing"
+)
+
+func TestBinarySearch(t *testing.T) {
+        ascending := NaturalOrder[int]()
+        sorted := []int{1, 3, 3, 3, 5, 8}
+        for _, tc := range []struct {
+                target       int
+                position     int
+                found        bool
+                lower, upper int
+        }{
+                {0, 0, false, 0, 0},
+                {3, 1, true, 1, 4},
+                {4, 4, false, 4, 4},
+                {8, 5, true, 5, 6},
+                {9, 6, false, 6, 6},
+        } {
+                if position, found := BinarySearchBy(ascending, sorted, tc.target); position != tc.position || found != tc.found {
+                        t.Errorf("BinarySearchBy(%d) = %d, %v, want %d, %v", tc.target, position, found, tc.position, tc.found)
+                }
+                if lower, upper := EqualRange(ascending, sorted, tc.target); lower != tc.lower || upper != tc.upper {
+                        t.Errorf("EqualRange(%d) = %d, %d, want %d, %d", tc.target, lower, upper, tc.lower, tc.upper)
+                }
+        }
+
+        byAge := ComparingBy(func(tu testUser) int { return tu.age })
+        users := []testUser{john, sarah, kyle}
+        if lower, upper := LowerBound(byAge, users, kyle), UpperBound(byAge, users, kyle); lower != 1 || upper != 3 {
+                t.Errorf("LowerBound(), UpperBound() = %d, %d, want 1, 3", lower, upper)
+        }
+}
+
+func TestInsertSorted(t *testing.T) {
+        byAge := ComparingBy(func(tu testUser) int { return tu.age })
+        users := []testUser{}
+        for _, user := range []testUser{sarah, john, kyle} {
+                users = InsertSorted(byAge, users, user)
+        }
+        if want := []testUser{john, sarah, kyle}; !reflect.DeepEqual(want, users) {
+                t.Errorf("InsertSorted() = %v, want %v", users, want)
+        }
+}
+
+func TestMergeSorted(t *testing.T) {
+        byAge := ComparingBy(func(tu testUser) int { return tu.age })
+        merged := MergeSorted(byAge, []testUser{john, kyle}, []testUser{sarah})
+        if want := []testUser{john, kyle, sarah}; !reflect.DeepEqual(want, merged) {
+                t.Errorf("MergeSorted() = %v, want %v", merged, want)
+        }
+        numbers := MergeSorted(NaturalOrder[int](), []int{1, 4, 9}, []int{2, 3, 10, 11})
+        if want := []int{1, 2, 3, 4, 9, 10, 11}; !reflect.DeepEqual(want, numbers) {
+                t.Errorf("MergeSorted() = %v, want %v", numbers, want)
+        }
+        if empty := MergeSorted(NaturalOrder[int](), nil, nil); len(empty) != 0 {
+                t.Errorf("MergeSorted() = %v, want no elements", empty)
+        }
+}
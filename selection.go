@@ -0,0 +1,149 @@
+package collection
+
+import (
+        "container/heap"
+        "fmt"
+        "math/rand/v2"
+        "slices"
+)
+
+// boundedHeap is a min-heap, according to the comparator, that keeps the k largest elements pushed into it.
+type boundedHeap[T any] struct {
+        items      []T
+        comparator Comparator[T]
+}
+
+func (h *boundedHeap[T]) Len() int           { return len(h.items) }
+func (h *boundedHeap[T]) Less(i, j int) bool { return h.comparator(h.items[i], h.items[j]) < 0 }
+func (h *boundedHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *boundedHeap[T]) Push(item any)      { h.items = append(h.items, item.(T)) }
+func (h *boundedHeap[T]) Pop() any {
+        last := h.items[len(h.items)-1]
+        h.items = h.items[:len(h.items)-1]
+        return last
+}
+
+// TopK returns the k largest elements of the source according to the comparator, from the largest to the smallest.
+// It keeps at most k elements in memory while reading the source, so it is cheaper than sorting the whole source.
+// If the source has less than k elements, all of them are returned. The order of the equal elements is not defined.
+// Parameters:
+//   - comparator: a function that takes two values of type T and returns an integer indicating their order.
+//   - k: the number of elements to return.
+//   - source: the collection of elements to select from.
+//   - opts: options that customize the iteration, like SortedKeys.
+//
+// Returns:
+//   - []T: the k largest elements, sorted from the largest.
+//   - error: an error if the source is not of the appropriate type or if any other problem occurs during the operation.
+func TopK[T any](comparator Comparator[T], k int, source any, opts ...Option) ([]T, error) {
+        if k < 0 {
+                k = 0
+        }
+        h := &boundedHeap[T]{items: make([]T, 0, k), comparator: comparator}
+        var action ActionErr[T] = func(index int, item T) error {
+                if h.Len() < k {
+                        heap.Push(h, item)
+                } else if k > 0 && comparator(item, h.items[0]) > 0 {
+                        h.items[0] = item
+                        heap.Fix(h, 0)
+                }
+                return nil
+        }
+        if err := run(action, source, newConfig(opts)); err != nil {
+                return nil, err
+        }
+
+        result := make([]T, h.Len())
+        for index := len(result) - 1; index >= 0; index-- {
+                result[index] = heap.Pop(h).(T)
+        }
+        return result, nil
+}
+
+// BottomK returns the k smallest elements of the source according to the comparator, from the smallest to the largest.
+// Like TopK, it keeps at most k elements in memory while reading the source.
+func BottomK[T any](comparator Comparator[T], k int, source any, opts ...Option) ([]T, error) {
+        return TopK(comparator.Reversed(), k, source, opts...)
+}
+
+// NthElement returns the element that would be at the position n (starting at 0) if the source were sorted by the
+// comparator, without sorting it. It runs in linear time on average.
+// Parameters:
+//   - comparator: a function that takes two values of type T and returns an integer indicating their order.
+//   - n: the position of the element in the sorted order.
+//   - source: the collection of elements to select from. It is not modified.
+//   - opts: options that customize the iteration, like SortedKeys.
+//
+// Returns:
+//   - T: the n-th element in the sorted order.
+//   - error: an error wrapping ErrIndexOutOfRange if n is not a position of the source, or an error if the source
+//     is not of the appropriate type.
+func NthElement[T any](comparator Comparator[T], n int, source any, opts ...Option) (result T, err error) {
+        items, err := collect[T](source, opts)
+        if err != nil {
+                return
+        }
+        if n < 0 || n >= len(items) {
+                err = fmt.Errorf("%w: %d is not a position of a source of %d elements", ErrIndexOutOfRange, n, len(items))
+                return
+        }
+        selectNth(items, n, comparator)
+        return items[n], nil
+}
+
+// PartialSort returns a copy of the elements of the source whose first k elements are the k smallest according to
+// the comparator, sorted. The rest of the elements follow in no particular order. If k is greater than the number of
+// elements, the whole copy is sorted.
+// Parameters:
+//   - comparator: a function that takes two values of type T and returns an integer indicating their order.
+//   - k: the number of elements to sort.
+//   - source: the collection of elements to sort. It is not modified.
+//   - opts: options that customize the iteration, like SortedKeys.
+//
+// Returns:
+//   - []T: the partially sorted elements.
+//   - error: an error if the source is not of the appropriate type or if any other problem occurs during the operation.
+func PartialSort[T any](comparator Comparator[T], k int, source any, opts ...Option) ([]T, error) {
+        items, err := collect[T](source, opts)
+        if err != nil {
+                return nil, err
+        }
+        k = min(max(k, 0), len(items))
+        if k < len(items) {
+                selectNth(items, k, comparator)
+        }
+        slices.SortFunc(items[:k], comparator)
+        return items, nil
+}
+
+// selectNth reorders the items so the item at the position n is the one that would be there if the items were
+// sorted, every item before it is not greater and every item after it is not less. It uses quickselect with a
+// random pivot and a three-way partition, so repeated items do not degrade it.
+func selectNth[T any](items []T, n int, comparator Comparator[T]) {
+        low, high := 0, len(items)-1
+        for low < high {
+                pivot := items[low+rand.IntN(high-low+1)]
+                less, greater := low, high
+                for index := low; index <= greater; {
+                        switch result := comparator(items[index], pivot); {
+                        case result < 0:
+                                items[less], items[index] = items[index], items[less]
+                                less++
+                                index++
+                        case result > 0:
+                                items[index], items[greater] = items[greater], items[index]
+                                greater--
+                        default:
+                                index++
+                        }
+                }
+                switch {
+                case n < less:
+                        high = less - 1
+                case n > greater:
+                        low = greater + 1
+                default:
+                        return
+                }
+        }
+}
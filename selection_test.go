@@ -0,0 +1,85 @@
+package collection
+
+import (
+        "errors"
+        "math/rand/v2"
+        "reflect"
+        "slices"
+        "testing"
+)
+
+func generateRandomNumbers(size int) []int {
+        numbers := make([]int, size)
+        for index := range numbers {
+                numbers[index] = rand.IntN(size / 2)
+        }
+        return numbers
+}
+
+func TestTopKAndBottomK(t *testing.T) {
+        ascending := NaturalOrder[int]()
+        numbers := generateRandomNumbers(1000)
+        sorted := slices.Sorted(slices.Values(numbers))
+
+        top, err := TopK(ascending, 10, numbers)
+        if err != nil {
+                t.Fatalf("TopK failed: %v", err)
+        }
+        want := slices.Clone(sorted[len(sorted)-10:])
+        slices.Reverse(want)
+        if !reflect.DeepEqual(want, top) {
+                t.Errorf("TopK() = %v, want %v", top, want)
+        }
+        bottom, err := BottomK(ascending, 10, numbers)
+        if err != nil || !reflect.DeepEqual(sorted[:10], bottom) {
+                t.Errorf("BottomK() = %v, %v, want %v", bottom, err, sorted[:10])
+        }
+
+        oldest, err := TopK(ComparingBy(func(e Entry[string, int]) int { return e.Value }), 5, map[string]int{"a": 1, "b": 3})
+        if want := []Entry[string, int]{{"b", 3}, {"a", 1}}; err != nil || !reflect.DeepEqual(want, oldest) {
+                t.Errorf("TopK() = %v, %v, want %v", oldest, err, want)
+        }
+        if none, err := TopK(ascending, 0, numbers); err != nil || len(none) != 0 {
+                t.Errorf("TopK() = %v, %v, want no elements", none, err)
+        }
+}
+
+func TestNthElement(t *testing.T) {
+        ascending := NaturalOrder[int]()
+        numbers := generateRandomNumbers(1000)
+        original := slices.Clone(numbers)
+        sorted := slices.Sorted(slices.Values(numbers))
+        for _, n := range []int{0, 1, 499, 500, 998, 999} {
+                if got, err := NthElement(ascending, n, numbers); err != nil || got != sorted[n] {
+                        t.Errorf("NthElement(%d) = %v, %v, want %v", n, got, err, sorted[n])
+                }
+        }
+        if !reflect.DeepEqual(original, numbers) {
+                t.Errorf("NthElement() should not modify the source")
+        }
+        if _, err := NthElement(ascending, 3, []int{1, 2, 3}); !errors.Is(err, ErrIndexOutOfRange) {
+                t.Errorf("NthElement() = %v, want ErrIndexOutOfRange", err)
+        }
+}
+
+func TestPartialSort(t *testing.T) {
+        ascending := NaturalOrder[int]()
+        numbers := generateRandomNumbers(1000)
+        sorted := slices.Sorted(slices.Values(numbers))
+
+        partial, err := PartialSort(ascending, 20, numbers)
+        if err != nil {
+                t.Fatalf("PartialSort failed: %v", err)
+        }
+        if !reflect.DeepEqual(sorted[:20], partial[:20]) {
+                t.Errorf("PartialSort() = %v, want %v", partial[:20], sorted[:20])
+        }
+        if rest := slices.Sorted(slices.Values(partial[20:])); !reflect.DeepEqual(sorted[20:], rest) {
+                t.Errorf("PartialSort() should keep the rest of the elements")
+        }
+
+        all, err := PartialSort(ascending, 10, [3]int{3, 1, 2})
+        if err != nil || !reflect.DeepEqual([]int{1, 2, 3}, all) {
+                t.Errorf("PartialSort() = %v, %v, want [1 2 3]", all, err)
+        }
+}
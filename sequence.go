@@ -0,0 +1,200 @@
+package collection
+
+import (
+        "fmt"
+        "iter"
+        "reflect"
+)
+
+// elements lazily enumerates the source collection, yielding the position of every element together with the element.
+// The supported sources are slices and arrays of K, or pointers to them, maps (whose entries are yielded as Entry when K is an Entry, and as Touple
+// otherwise), sequences of K (iter.Seq[K]), channels of K (received until closed or until the context is done)
+// and the collections of the package, like Set. The keys of a map are visited in the order set by the configuration.
+func elements[K any](src any, cfg config) iter.Seq2[int, any] {
+        return func(yield func(int, any) bool) {
+                switch typed := src.(type) {
+                case iter.Seq[K]:
+                        index := 0
+                        for item := range typed {
+                                if !yield(index, item) {
+                                        return
+                                }
+                                index++
+                        }
+                case func(func(K) bool):
+                        elements[K](iter.Seq[K](typed), cfg)(yield)
+                case <-chan K:
+                        elements[K](receive(typed, cfg.context()), cfg)(yield)
+                case chan K:
+                        elements[K](receive(typed, cfg.context()), cfg)(yield)
+                case keyed:
+                        index := 0
+                        for key, value := range typed.entries() {
+                                if !yield(index, entryOf[K](key, value)) {
+                                        return
+                                }
+                                index++
+                        }
+                case enumerable:
+                        index := 0
+                        for item := range typed.values() {
+                                if !yield(index, item) {
+                                        return
+                                }
+                                index++
+                        }
+                default:
+                        if IsMap(src) {
+                                val := reflect.ValueOf(src)
+                                for index, key := range cfg.sortKeys(val.MapKeys()) {
+                                        value := val.MapIndex(key)
+                                        if !yield(index, entryOf[K](key.Interface(), value.Interface())) {
+                                                return
+                                        }
+                                }
+                                return
+                        }
+                        items, ok := sliceOf[K](src)
+                        if !ok {
+                                panic(fmt.Errorf("%w: %T", ErrUnsupportedSource, src))
+                        }
+                        for index, item := range items {
+                                if !yield(index, item) {
+                                        return
+                                }
+                        }
+                }
+        }
+}
+
+// sliceOf returns the elements of a list source as a slice, and whether the source is a list of K.
+// A []K or a *[]K is returned as is, a slice of a named type whose elements are K (or a pointer to it) is converted
+// sharing its backing array, a *[N]K is returned as a slice backed by the array, and a [N]K as a copy.
+func sliceOf[K any](src any) ([]K, bool) {
+        switch typed := src.(type) {
+        case []K:
+                return typed, true
+        case *[]K:
+                if typed == nil {
+                        return nil, true
+                }
+                return *typed, true
+        }
+        val := reflect.ValueOf(src)
+        if val.Kind() == reflect.Ptr && !val.IsNil() {
+                val = val.Elem()
+        }
+        if (val.Kind() != reflect.Array && val.Kind() != reflect.Slice) || val.Type().Elem() != reflect.TypeFor[K]() {
+                return nil, false
+        }
+        if val.Kind() == reflect.Slice {
+                return val.Convert(reflect.TypeFor[[]K]()).Interface().([]K), true
+        }
+        if !val.CanAddr() {
+                array := reflect.New(val.Type()).Elem()
+                array.Set(val)
+                val = array
+        }
+        return val.Slice(0, val.Len()).Interface().([]K), true
+}
+
+// Values returns a lazy sequence over the elements of the source, so it can be used directly in a `for range` loop.
+// The source can be a slice, a map or another sequence, the same sources accepted by ForEach, Filter, Map and GroupBy.
+// If the source is a map, T must be Touple or Entry, and its entries are yielded in the order set by SortedKeys or
+// WithKeyComparator, if any. Since a sequence can not return errors, iterating an unsupported source panics.
+func Values[T any](src any, opts ...Option) iter.Seq[T] {
+        return func(yield func(T) bool) {
+                for _, item := range elements[T](src, newConfig(opts)) {
+                        // A nil element is yielded as the zero value of T, as evaluate does.
+                        typedItem, _ := item.(T)
+                        if item != nil {
+                                typedItem = item.(T)
+                        }
+                        if !yield(typedItem) {
+                                return
+                        }
+                }
+        }
+}
+
+// Touples adapts a sequence of key-value pairs (like the one returned by maps.All) to a sequence of Touple,
+// so it can be used as source of ForEach, Filter, Map and GroupBy.
+func Touples[K, V any](seq iter.Seq2[K, V]) iter.Seq[Touple] {
+        return func(yield func(Touple) bool) {
+                for key, value := range seq {
+                        if !yield(Touple{key, value}) {
+                                return
+                        }
+                }
+        }
+}
+
+// FilterSeq returns a lazy sequence with the elements of seq that satisfy the predicate.
+// The predicate is evaluated only when the returned sequence is consumed.
+func FilterSeq[T any](predicate Predicate[T], seq iter.Seq[T]) iter.Seq[T] {
+        return func(yield func(T) bool) {
+                for item := range seq {
+                        if predicate(item) && !yield(item) {
+                                return
+                        }
+                }
+        }
+}
+
+// MapSeq returns a lazy sequence with the result of applying the mapper to each element of seq.
+// The mapper is evaluated only when the returned sequence is consumed.
+func MapSeq[T, R any](mapper func(T) R, seq iter.Seq[T]) iter.Seq[R] {
+        return func(yield func(R) bool) {
+                for item := range seq {
+                        if !yield(mapper(item)) {
+                                return
+                        }
+                }
+        }
+}
+
+// GroupBySeq returns a sequence of the groups of seq, keyed by the result of the keySelector.
+// The groups are yielded in the order their keys were first seen. The input sequence is consumed
+// completely the first time the result is iterated, since a group is not complete until the end of the input.
+func GroupBySeq[T any, K comparable](keySelector func(T) K, seq iter.Seq[T]) iter.Seq2[K, []T] {
+        return func(yield func(K, []T) bool) {
+                keys := []K{}
+                groups := map[K][]T{}
+                for item := range seq {
+                        key := keySelector(item)
+                        if _, found := groups[key]; !found {
+                                keys = append(keys, key)
+                        }
+                        groups[key] = append(groups[key], item)
+                }
+                for _, key := range keys {
+                        if !yield(key, groups[key]) {
+                                return
+                        }
+                }
+        }
+}
+
+// All returns a lazy sequence over the elements of the stream.
+// If the pipeline failed, the sequence is empty; use Err to retrieve the error.
+func (s *Stream[T]) All() iter.Seq[T] {
+        return func(yield func(T) bool) {
+                if s.err != nil {
+                        return
+                }
+                for _, item := range s.items {
+                        if !yield(item) {
+                                return
+                        }
+                }
+        }
+}
+
+// FromSeq creates a new Stream with the elements of the sequence.
+func FromSeq[T any](seq iter.Seq[T]) *Stream[T] {
+        items := []T{}
+        for item := range seq {
+                items = append(items, item)
+        }
+        return From(items)
+}
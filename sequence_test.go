@@ -0,0 +1,80 @@
+package collection
+
+import (
+        "maps"
+        "reflect"
+        "slices"
+        "testing"
+)
+
+func TestValues(t *testing.T) {
+        got := []string{}
+        for name := range MapSeq(func(tu testUser) string { return tu.name }, Values[testUser](generateTestCaseList())) {
+                got = append(got, name)
+        }
+        if want := []string{"John", "Sarah", "Kyle"}; !reflect.DeepEqual(want, got) {
+                t.Errorf("Values() = %v, want %v", got, want)
+        }
+
+        if got := slices.Collect(Values[any]([]any{1, nil})); !reflect.DeepEqual([]any{1, nil}, got) {
+                t.Errorf("Values() = %v, want [1 <nil>]", got)
+        }
+        if got := slices.Collect(Values[error]([]error{nil})); len(got) != 1 || got[0] != nil {
+                t.Errorf("Values() = %v, want [<nil>]", got)
+        }
+}
+
+func TestFilterSeqIsLazy(t *testing.T) {
+        evaluated := 0
+        isEven := func(n int) bool {
+                evaluated++
+                return n%2 == 0
+        }
+        for n := range FilterSeq(isEven, slices.Values([]int{1, 2, 3, 4, 5, 6})) {
+                if n == 2 {
+                        break
+                }
+        }
+        if evaluated != 2 {
+                t.Errorf("FilterSeq() evaluated %d items, want 2", evaluated)
+        }
+}
+
+func TestGroupBySeq(t *testing.T) {
+        keys := []bool{}
+        groups := map[bool][]testUser{}
+        for key, group := range GroupBySeq(isMale, slices.Values(generateTestCaseList())) {
+                keys = append(keys, key)
+                groups[key] = group
+        }
+        if want := []bool{true, false}; !reflect.DeepEqual(want, keys) {
+                t.Errorf("GroupBySeq() keys = %v, want %v", keys, want)
+        }
+        if want := map[bool][]testUser{true: {john, kyle}, false: {sarah}}; !reflect.DeepEqual(want, groups) {
+                t.Errorf("GroupBySeq() = %v, want %v", groups, want)
+        }
+}
+
+func TestSequenceAsSource(t *testing.T) {
+        males := []testUser{}
+        if err := Filter(isMale, slices.Values(generateTestCaseList()), &males); err != nil {
+                t.Fatalf("Filter failed: %v", err)
+        }
+        if want := []testUser{john, kyle}; !reflect.DeepEqual(want, males) {
+                t.Errorf("Filter() = %v, want %v", males, want)
+        }
+
+        names := []string{}
+        if err := Map(mapperToNamesFromMap, Touples(maps.All(generateTestCaseMap())), &names); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        slices.Sort(names)
+        if want := []string{"John Connor", "Kyle Risk", "Sarah Connor"}; !reflect.DeepEqual(want, names) {
+                t.Errorf("Map() = %v, want %v", names, want)
+        }
+
+        sorted, _ := FromSeq(From([]int{3, 1, 2}).All()).SortBy(func(a, b int) int { return a - b }).Collect()
+        if want := []int{1, 2, 3}; !reflect.DeepEqual(want, sorted) {
+                t.Errorf("FromSeq() = %v, want %v", sorted, want)
+        }
+}
@@ -0,0 +1,161 @@
+package collection
+
+import (
+        "iter"
+        "reflect"
+)
+
+// enumerable is implemented by the collections of the package that can be used as source of the operations.
+type enumerable interface {
+        values() iter.Seq[any]
+        elemType() reflect.Type
+}
+
+// container is implemented by the collections of the package that can be used as destination of the operations.
+type container interface {
+        put(data any, merge MergeFunc) error
+}
+
+// Set is an unordered collection of unique elements of type T.
+// A *Set can be used as source and as destination of ForEach, Filter, Map and the rest of operations.
+// The zero value is an empty set ready to use.
+type Set[T comparable] struct {
+        items map[T]struct{}
+}
+
+// NewSet creates a new Set with the given elements. Duplicated elements are added only once,
+// so NewSet(slice...) converts a slice into a Set.
+func NewSet[T comparable](items ...T) *Set[T] {
+        s := &Set[T]{items: make(map[T]struct{}, len(items))}
+        s.Add(items...)
+        return s
+}
+
+// Add adds the elements to the set.
+func (s *Set[T]) Add(items ...T) {
+        if s.items == nil {
+                s.items = make(map[T]struct{}, len(items))
+        }
+        for _, item := range items {
+                s.items[item] = struct{}{}
+        }
+}
+
+// Remove removes the elements from the set. Elements that are not in the set are ignored.
+func (s *Set[T]) Remove(items ...T) {
+        for _, item := range items {
+                delete(s.items, item)
+        }
+}
+
+// Contains reports whether the element is in the set.
+func (s *Set[T]) Contains(item T) bool {
+        _, found := s.items[item]
+        return found
+}
+
+// Len returns the number of elements of the set.
+func (s *Set[T]) Len() int {
+        return len(s.items)
+}
+
+// ToSlice returns the elements of the set as a slice, in no particular order.
+func (s *Set[T]) ToSlice() []T {
+        result := make([]T, 0, len(s.items))
+        for item := range s.items {
+                result = append(result, item)
+        }
+        return result
+}
+
+// All returns a sequence over the elements of the set, in no particular order.
+func (s *Set[T]) All() iter.Seq[T] {
+        return func(yield func(T) bool) {
+                for item := range s.items {
+                        if !yield(item) {
+                                return
+                        }
+                }
+        }
+}
+
+// Union returns a new set with the elements that are in s, in other or in both.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+        result := NewSet(s.ToSlice()...)
+        result.Add(other.ToSlice()...)
+        return result
+}
+
+// Intersection returns a new set with the elements that are both in s and in other.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+        result := NewSet[T]()
+        for item := range s.items {
+                if other.Contains(item) {
+                        result.Add(item)
+                }
+        }
+        return result
+}
+
+// Difference returns a new set with the elements of s that are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+        result := NewSet[T]()
+        for item := range s.items {
+                if !other.Contains(item) {
+                        result.Add(item)
+                }
+        }
+        return result
+}
+
+// SymmetricDifference returns a new set with the elements that are either in s or in other, but not in both.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+        return s.Difference(other).Union(other.Difference(s))
+}
+
+// IsSubsetOf reports whether every element of s is in other.
+func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
+        if s.Len() > other.Len() {
+                return false
+        }
+        for item := range s.items {
+                if !other.Contains(item) {
+                        return false
+                }
+        }
+        return true
+}
+
+// IsSupersetOf reports whether every element of other is in s.
+func (s *Set[T]) IsSupersetOf(other *Set[T]) bool {
+        return other.IsSubsetOf(s)
+}
+
+// Equal reports whether both sets have the same elements.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+        return s.Len() == other.Len() && s.IsSubsetOf(other)
+}
+
+func (s *Set[T]) values() iter.Seq[any] {
+        return func(yield func(any) bool) {
+                for item := range s.items {
+                        if !yield(item) {
+                                return
+                        }
+                }
+        }
+}
+
+func (s *Set[T]) elemType() reflect.Type {
+        return reflect.TypeFor[T]()
+}
+
+// put adds the element to the set, converting it like store does for the values of a map.
+func (s *Set[T]) put(data any, merge MergeFunc) error {
+        item, err := typed[T](convertTo(data, reflect.TypeFor[T]()))
+        if err != nil {
+                return err
+        }
+        s.Add(item)
+        return nil
+}
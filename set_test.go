@@ -0,0 +1,83 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "slices"
+        "testing"
+)
+
+func TestSetAlgebra(t *testing.T) {
+        a := NewSet(1, 2, 3, 3)
+        b := NewSet(3, 4)
+
+        if a.Len() != 3 || !a.Contains(2) || a.Contains(4) {
+                t.Errorf("NewSet() = %v, want {1, 2, 3}", a.ToSlice())
+        }
+
+        tests := []struct {
+                name string
+                got  *Set[int]
+                want []int
+        }{
+                {"Union", a.Union(b), []int{1, 2, 3, 4}},
+                {"Intersection", a.Intersection(b), []int{3}},
+                {"Difference", a.Difference(b), []int{1, 2}},
+                {"SymmetricDifference", a.SymmetricDifference(b), []int{1, 2, 4}},
+        }
+        for _, tt := range tests {
+                t.Run(tt.name, func(t *testing.T) {
+                        got := tt.got.ToSlice()
+                        slices.Sort(got)
+                        if !reflect.DeepEqual(tt.want, got) {
+                                t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+                        }
+                })
+        }
+
+        if !NewSet(1, 3).IsSubsetOf(a) || a.IsSubsetOf(b) || !a.IsSupersetOf(NewSet(2)) {
+                t.Errorf("IsSubsetOf()/IsSupersetOf() failed")
+        }
+
+        a.Remove(1, 5)
+        if !a.Equal(NewSet(2, 3)) {
+                t.Errorf("Remove() = %v, want {2, 3}", a.ToSlice())
+        }
+
+        var empty Set[string]
+        empty.Add("a")
+        if !empty.Contains("a") {
+                t.Errorf("the zero value of Set should be usable")
+        }
+}
+
+func TestSetAsSourceAndDestination(t *testing.T) {
+        surnames := NewSet[string]()
+        var mapper Mapper[testUser] = func(tu testUser) any {
+                return tu.secondName
+        }
+        if err := Map(mapper, generateTestCaseList(), surnames); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        if !surnames.Equal(NewSet("Connor", "Risk")) {
+                t.Errorf("Map() = %v, want {Connor, Risk}", surnames.ToSlice())
+        }
+
+        long := []string{}
+        if err := Filter(func(s string) bool { return len(s) > 4 }, surnames, &long); err != nil {
+                t.Fatalf("Filter failed: %v", err)
+        }
+        if want := []string{"Connor"}; !reflect.DeepEqual(want, long) {
+                t.Errorf("Filter() = %v, want %v", long, want)
+        }
+
+        var panicErr *PanicError
+        if err := Map(func(n int) any { return n }, []int{1}, surnames); err == nil || errors.As(err, &panicErr) {
+                t.Errorf("Map() = %v, want an error storing an int into a set of strings", err)
+        }
+
+        errs := NewSet[error]()
+        if err := Map(func(n int) any { return nil }, []int{1}, errs); err != nil || !errs.Contains(nil) {
+                t.Errorf("Map() = %v, %v, want nil stored into a set of errors", err, errs.ToSlice())
+        }
+}
@@ -0,0 +1,82 @@
+package collection
+
+import "sort"
+
+// StableSortBy sorts the elements in the source using the provided comparator function, keeping the original order
+// of the elements that are equal according to the comparator.
+// Parameters:
+//   - comparator: a function that takes two values of type T and returns an integer indicating their order.
+//   - source: a pointer to the list (array or slice) to be sorted.
+//
+// Returns:
+//   - error: an error if the source is not of the appropriate type or if any other problem occurs during the operation.
+func StableSortBy[T any](comparator Comparator[T], source any) error {
+        lst, err := sortable[T](source)
+        if err != nil {
+                return err
+        }
+
+        sort.SliceStable(lst, func(i, j int) bool {
+                return comparator(lst[i], lst[j]) < 0
+        })
+        return nil
+}
+
+// Sorted returns a new slice with the elements of the source sorted by the comparator, leaving the source untouched.
+// The sort is stable. If the source is a map, T must be Touple or Entry.
+// Parameters:
+//   - comparator: a function that takes two values of type T and returns an integer indicating their order.
+//   - source: the collection of elements to be sorted.
+//   - opts: options that customize the iteration, like SortedKeys to read a map source in a deterministic order.
+//
+// Returns:
+//   - []T: the sorted elements.
+//   - error: an error if the source is not of the appropriate type or if any other problem occurs during the operation.
+func Sorted[T any](comparator Comparator[T], source any, opts ...Option) ([]T, error) {
+        items, err := collect[T](source, opts)
+        if err != nil {
+                return nil, err
+        }
+        err = StableSortBy(comparator, &items)
+        return items, err
+}
+
+// collect copies the elements of the source into a new slice.
+func collect[T any](source any, opts []Option) ([]T, error) {
+        items := []T{}
+        var action ActionErr[T] = func(index int, item T) error {
+                items = append(items, item)
+                return nil
+        }
+        if err := run(action, source, newConfig(opts)); err != nil {
+                return nil, err
+        }
+        return items, nil
+}
+
+// IsSorted reports whether the elements of the source are sorted according to the comparator.
+// If the source is a map, T must be Touple or Entry.
+// Parameters:
+//   - comparator: a function that takes two values of type T and returns an integer indicating their order.
+//   - source: the collection of elements to be checked.
+//   - opts: options that customize the iteration, like SortedKeys to read a map source in a deterministic order.
+//
+// Returns:
+//   - bool: true if every element is not less than the previous one.
+//   - error: an error if the source is not of the appropriate type or if any other problem occurs during the operation.
+func IsSorted[T any](comparator Comparator[T], source any, opts ...Option) (bool, error) {
+        sorted := true
+        var previous T
+        var action ActionErr[T] = func(index int, item T) error {
+                if index > 0 && comparator(item, previous) < 0 {
+                        sorted = false
+                        return ErrStop
+                }
+                previous = item
+                return nil
+        }
+        if err := run(action, source, newConfig(opts)); err != nil {
+                return false, err
+        }
+        return sorted, nil
+}
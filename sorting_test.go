@@ -0,0 +1,64 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "testing"
+)
+
+func TestStableSortBy(t *testing.T) {
+        byAge := ComparingBy(func(tu testUser) int { return tu.age })
+        for range 5 {
+                users := []testUser{kyle, sarah, john, sarah, kyle}
+                if err := StableSortBy(byAge, &users); err != nil {
+                        t.Fatalf("StableSortBy failed: %v", err)
+                }
+                if want := []testUser{john, kyle, sarah, sarah, kyle}; !reflect.DeepEqual(want, users) {
+                        t.Fatalf("StableSortBy() = %v, want %v", users, want)
+                }
+        }
+        if err := StableSortBy(byAge, generateTestCaseList()); !errors.Is(err, ErrUnsupportedSource) {
+                t.Errorf("StableSortBy() = %v, want ErrUnsupportedSource", err)
+        }
+}
+
+func TestSorted(t *testing.T) {
+        users := generateTestCaseList()
+        sorted, err := Sorted(ComparingBy(func(tu testUser) string { return tu.name }), users)
+        if err != nil {
+                t.Fatalf("Sorted failed: %v", err)
+        }
+        if want := []testUser{john, kyle, sarah}; !reflect.DeepEqual(want, sorted) {
+                t.Errorf("Sorted() = %v, want %v", sorted, want)
+        }
+        if !reflect.DeepEqual(generateTestCaseList(), users) {
+                t.Errorf("Sorted() should not modify the source")
+        }
+
+        byValue := ComparingBy(func(e Entry[string, int]) int { return e.Value }).
+                ThenComparing(ComparingBy(func(e Entry[string, int]) string { return e.Key }))
+        entries, err := Sorted(byValue, map[string]int{"b": 2, "a": 2, "c": 1})
+        if want := []Entry[string, int]{{"c", 1}, {"a", 2}, {"b", 2}}; err != nil || !reflect.DeepEqual(want, entries) {
+                t.Errorf("Sorted() = %v, %v, want %v", entries, err, want)
+        }
+}
+
+func TestIsSorted(t *testing.T) {
+        ascending := NaturalOrder[int]()
+        for _, tc := range []struct {
+                source any
+                want   bool
+        }{
+                {[]int{}, true},
+                {[]int{1, 1, 2, 3}, true},
+                {[]int{1, 3, 2}, false},
+                {[3]int{3, 2, 1}, false},
+        } {
+                if got, err := IsSorted(ascending, tc.source); err != nil || got != tc.want {
+                        t.Errorf("IsSorted(%v) = %v, %v, want %v", tc.source, got, err, tc.want)
+                }
+        }
+        if _, err := IsSorted(ascending, []string{}); !errors.Is(err, ErrUnsupportedSource) {
+                t.Errorf("IsSorted() = %v, want ErrUnsupportedSource", err)
+        }
+}
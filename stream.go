@@ -0,0 +1,114 @@
+package collection
+
+// Stream is a fluent, type-safe pipeline over a list of elements of type T.
+// Every step of the pipeline delegates to the free functions of the package (Filter, Map, SortBy, ...),
+// so the same error capture applies, but the element type is checked at compile time and the
+// result is returned as a typed slice or map instead of being written into an `any` destination.
+//
+// Once a step fails, the following steps are skipped and the error is reported by Collect
+// (or by any other terminal operation).
+type Stream[T any] struct {
+        items []T
+        err   error
+}
+
+// From creates a new Stream over the elements of the given slice.
+// The slice is never modified by the operations of the stream.
+func From[T any](src []T) *Stream[T] {
+        return &Stream[T]{items: src}
+}
+
+// Filter keeps only the elements that satisfy the predicate.
+func (s *Stream[T]) Filter(predicate Predicate[T]) *Stream[T] {
+        if s.err != nil {
+                return s
+        }
+        result := []T{}
+        err := Filter(predicate, s.items, &result)
+        return &Stream[T]{items: result, err: err}
+}
+
+// Map transforms every element of the stream into another element of the same type.
+// Use MapTo when the transformation changes the type of the elements.
+func (s *Stream[T]) Map(mapper func(T) T) *Stream[T] {
+        return MapTo(s, mapper)
+}
+
+// SortBy sorts the elements of the stream using the provided comparator.
+// The slice the stream was created from is not modified.
+func (s *Stream[T]) SortBy(comparator Comparator[T]) *Stream[T] {
+        if s.err != nil {
+                return s
+        }
+        result := make([]T, len(s.items))
+        copy(result, s.items)
+        err := SortBy(comparator, &result)
+        return &Stream[T]{items: result, err: err}
+}
+
+// ForEach applies the action to each element of the stream. It is a terminal operation.
+func (s *Stream[T]) ForEach(action Action[T]) error {
+        if s.err != nil {
+                return s.err
+        }
+        return ForEach(action, s.items)
+}
+
+// Collect returns the elements of the stream as a slice, or the first error produced by the pipeline.
+func (s *Stream[T]) Collect() ([]T, error) {
+        if s.err != nil {
+                return nil, s.err
+        }
+        return s.items, nil
+}
+
+// Err returns the first error produced by the pipeline, if any.
+func (s *Stream[T]) Err() error {
+        return s.err
+}
+
+// MapTo transforms every element of the stream into an element of type R.
+// It is a free function because Go methods can not declare their own type parameters.
+func MapTo[T, R any](s *Stream[T], mapper func(T) R) *Stream[R] {
+        if s.err != nil {
+                return &Stream[R]{err: s.err}
+        }
+        result := []R{}
+        var adapter Mapper[T] = func(item T) any {
+                return mapper(item)
+        }
+        err := Map(adapter, s.items, &result)
+        return &Stream[R]{items: result, err: err}
+}
+
+// CollectMap ends the stream building a map, where the keys and the values are obtained from each element
+// through the mapper function. Later elements overwrite earlier ones with the same key.
+func CollectMap[T any, K comparable, V any](s *Stream[T], mapper func(T) (K, V)) (map[K]V, error) {
+        if s.err != nil {
+                return nil, s.err
+        }
+        result := map[K]V{}
+        var adapter Action[T] = func(index int, item T) {
+                key, value := mapper(item)
+                result[key] = value
+        }
+        if err := ForEach(adapter, s.items); err != nil {
+                return nil, err
+        }
+        return result, nil
+}
+
+// CollectGroups ends the stream grouping its elements by the key returned by the keySelector.
+func CollectGroups[T any, K comparable](s *Stream[T], keySelector func(T) K) (map[K][]T, error) {
+        if s.err != nil {
+                return nil, s.err
+        }
+        result := map[K][]T{}
+        var adapter KeySelector[T] = func(item T) any {
+                return keySelector(item)
+        }
+        if err := GroupBy(adapter, s.items, result); err != nil {
+                return nil, err
+        }
+        return result, nil
+}
@@ -0,0 +1,80 @@
+package collection
+
+import (
+        "fmt"
+        "reflect"
+        "strings"
+        "testing"
+)
+
+func TestStream(t *testing.T) {
+        names, err := MapTo(From(generateTestCaseList()).Filter(isMale), func(tu testUser) string {
+                return fmt.Sprintf("%s %s", tu.name, tu.secondName)
+        }).SortBy(strings.Compare).Collect()
+        if err != nil {
+                t.Fatalf("Stream failed: %v", err)
+        }
+        if want := []string{"John Connor", "Kyle Risk"}; !reflect.DeepEqual(want, names) {
+                t.Errorf("Stream() = %v, want %v", names, want)
+        }
+
+        src := []int{3, 1, 2}
+        sorted, _ := From(src).Map(func(n int) int { return n * 10 }).SortBy(func(a, b int) int { return a - b }).Collect()
+        if want := []int{10, 20, 30}; !reflect.DeepEqual(want, sorted) {
+                t.Errorf("Stream() = %v, want %v", sorted, want)
+        }
+        if want := []int{3, 1, 2}; !reflect.DeepEqual(want, src) {
+                t.Errorf("Stream modified the source = %v, want %v", src, want)
+        }
+}
+
+func TestStreamError(t *testing.T) {
+        var predicateKO Predicate[testUser] = func(tu testUser) bool {
+                return tu.mails[3] != ""
+        }
+        called := false
+        _, err := From(generateTestCaseList()).Filter(predicateKO).Map(func(tu testUser) testUser {
+                called = true
+                return tu
+        }).Collect()
+        if err == nil {
+                t.Errorf("Collect() should fail")
+        }
+        if called {
+                t.Errorf("Map() should be skipped after a failure")
+        }
+}
+
+func TestStreamCollectors(t *testing.T) {
+        byName, err := CollectMap(From(generateTestCaseList()), func(tu testUser) (string, int) {
+                return tu.name, tu.age
+        })
+        if err != nil {
+                t.Fatalf("CollectMap failed: %v", err)
+        }
+        if want := map[string]int{"John": 10, "Sarah": 43, "Kyle": 43}; !reflect.DeepEqual(want, byName) {
+                t.Errorf("CollectMap() = %v, want %v", byName, want)
+        }
+
+        bySex, err := CollectGroups(From(generateTestCaseList()), func(tu testUser) bool {
+                return tu.male
+        })
+        if err != nil {
+                t.Fatalf("CollectGroups failed: %v", err)
+        }
+        if want := map[bool][]testUser{true: {john, kyle}, false: {sarah}}; !reflect.DeepEqual(want, bySex) {
+                t.Errorf("CollectGroups() = %v, want %v", bySex, want)
+        }
+}
+
+func TestStreamWithNilElements(t *testing.T) {
+        values, err := From([]any{1, nil, 2}).Filter(func(any) bool { return true }).Collect()
+        if want := []any{1, nil, 2}; err != nil || !reflect.DeepEqual(want, values) {
+                t.Errorf("Collect() = %v, %v, want %v", values, err, want)
+        }
+
+        errs, err := From([]error{nil, errOdd}).Filter(func(e error) bool { return e == nil }).Collect()
+        if want := []error{nil}; err != nil || !reflect.DeepEqual(want, errs) {
+                t.Errorf("Collect() = %v, %v, want %v", errs, err, want)
+        }
+}
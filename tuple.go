@@ -0,0 +1,140 @@
+package collection
+
+import (
+        "iter"
+        "reflect"
+)
+
+// keyValue is implemented by the types that hold a key-value pair, Touple and Entry, so both can be stored
+// into a map destination.
+type keyValue interface {
+        pair() (key any, value any)
+}
+
+// entryBuilder is implemented by the key-value types that can be built from the entries of a map source.
+type entryBuilder interface {
+        build(key any, value any) (any, bool)
+        accepts(keyType reflect.Type, valueType reflect.Type) bool
+}
+
+// keyed is implemented by the collections of the package that are iterated as key-value pairs, like OrderedMap.
+type keyed interface {
+        entries() iter.Seq2[any, any]
+        entryTypes() (keyType reflect.Type, valueType reflect.Type)
+}
+
+func (t Touple) pair() (any, any) {
+        return t.Key, t.Value
+}
+
+// entryOf builds the element yielded for an entry of a map source: an Entry when K is an Entry whose types
+// match the key and the value, and a Touple otherwise.
+func entryOf[K any](key any, value any) any {
+        var zero K
+        if builder, ok := any(zero).(entryBuilder); ok {
+                if entry, ok := builder.build(key, value); ok {
+                        return entry
+                }
+        }
+        return Touple{key, value}
+}
+
+// Entry is the typed counterpart of Touple: a key-value pair whose key is of type K and whose value is of type V.
+// When an operation is parametrized with an Entry, the entries of a map source are yielded as Entry instead of Touple,
+// so there is no need to type-assert the key and the value. An Entry can be stored into a map destination like a Touple.
+type Entry[K comparable, V any] struct {
+        Key   K // Key is the key of the key-value pair.
+        Value V // Value is the value of the key-value pair.
+}
+
+// NewEntry creates a new Entry with the given key and value.
+func NewEntry[K comparable, V any](key K, value V) Entry[K, V] {
+        return Entry[K, V]{Key: key, Value: value}
+}
+
+// AsEntry converts a Touple into an Entry, and reports whether the key and the value are of types K and V.
+func AsEntry[K comparable, V any](touple Touple) (Entry[K, V], bool) {
+        entry, ok := Entry[K, V]{}.build(touple.Key, touple.Value)
+        if !ok {
+                return Entry[K, V]{}, false
+        }
+        return entry.(Entry[K, V]), true
+}
+
+// Unpack returns the key and the value of the entry.
+func (e Entry[K, V]) Unpack() (K, V) {
+        return e.Key, e.Value
+}
+
+// ToTouple converts the entry into an untyped Touple.
+func (e Entry[K, V]) ToTouple() Touple {
+        return Touple{e.Key, e.Value}
+}
+
+func (e Entry[K, V]) pair() (any, any) {
+        return e.Key, e.Value
+}
+
+func (e Entry[K, V]) build(key any, value any) (any, bool) {
+        typedKey, okKey := key.(K)
+        typedValue, okValue := value.(V)
+        if !okKey || (!okValue && value != nil) {
+                return nil, false
+        }
+        return Entry[K, V]{typedKey, typedValue}, true
+}
+
+// accepts reports whether the entries of a map whose keys and values are of the given types can be built as this Entry.
+func (e Entry[K, V]) accepts(keyType reflect.Type, valueType reflect.Type) bool {
+        return keyType.AssignableTo(reflect.TypeFor[K]()) && valueType.AssignableTo(reflect.TypeFor[V]())
+}
+
+// Pair is a generic group of two values.
+type Pair[A, B any] struct {
+        First  A
+        Second B
+}
+
+// NewPair creates a new Pair with the given values.
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+        return Pair[A, B]{First: first, Second: second}
+}
+
+// Unpack returns the values of the pair.
+func (p Pair[A, B]) Unpack() (A, B) {
+        return p.First, p.Second
+}
+
+// Triple is a generic group of three values.
+type Triple[A, B, C any] struct {
+        First  A
+        Second B
+        Third  C
+}
+
+// NewTriple creates a new Triple with the given values.
+func NewTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+        return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// Unpack returns the values of the triple.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+        return t.First, t.Second, t.Third
+}
+
+// Entries adapts a sequence of key-value pairs (like the one returned by maps.All) to a sequence of Entry.
+func Entries[K comparable, V any](seq iter.Seq2[K, V]) iter.Seq[Entry[K, V]] {
+        return func(yield func(Entry[K, V]) bool) {
+                for key, value := range seq {
+                        if !yield(Entry[K, V]{key, value}) {
+                                return
+                        }
+                }
+        }
+}
+
+// FromMap creates a new Stream over the entries of the map. The options set the order of the entries,
+// for example SortedKeys.
+func FromMap[K comparable, V any](m map[K]V, opts ...Option) *Stream[Entry[K, V]] {
+        return FromSeq(Values[Entry[K, V]](m, opts...))
+}
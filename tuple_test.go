@@ -0,0 +1,77 @@
+package collection
+
+import (
+        "reflect"
+        "testing"
+)
+
+func TestEntryAsSource(t *testing.T) {
+        males := map[string]testUser{}
+        isMaleEntry := func(e Entry[string, testUser]) bool {
+                return e.Value.male
+        }
+        if err := Filter(isMaleEntry, generateTestCaseMap(), males); err != nil {
+                t.Fatalf("Filter failed: %v", err)
+        }
+        if want := map[string]testUser{"John": john, "Kyle": kyle}; !reflect.DeepEqual(want, males) {
+                t.Errorf("Filter() = %v, want %v", males, want)
+        }
+
+        ordered := NewOrderedMap[string, int]()
+        ordered.Set("b", 2)
+        ordered.Set("a", 1)
+        keys := []string{}
+        if err := Map(func(e Entry[string, int]) any { return e.Key }, ordered, &keys); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        if want := []string{"b", "a"}; !reflect.DeepEqual(want, keys) {
+                t.Errorf("Map() = %v, want %v", keys, want)
+        }
+
+        if err := ForEach(func(int, Entry[int, int]) {}, generateTestCaseMap()); err == nil {
+                t.Errorf("ForEach() should fail when the types of the Entry do not match the map")
+        }
+}
+
+func TestEntryAsDestination(t *testing.T) {
+        ages := map[string]int{}
+        var toEntry Mapper[testUser] = func(tu testUser) any {
+                return NewEntry(tu.name, tu.age)
+        }
+        if err := Map(toEntry, generateTestCaseList(), ages); err != nil {
+                t.Fatalf("Map failed: %v", err)
+        }
+        if want := map[string]int{"John": 10, "Sarah": 43, "Kyle": 43}; !reflect.DeepEqual(want, ages) {
+                t.Errorf("Map() = %v, want %v", ages, want)
+        }
+
+        names, err := MapTo(FromMap(ages, SortedKeys()), func(e Entry[string, int]) string {
+                return e.Key
+        }).Collect()
+        if err != nil {
+                t.Fatalf("FromMap failed: %v", err)
+        }
+        if want := []string{"John", "Kyle", "Sarah"}; !reflect.DeepEqual(want, names) {
+                t.Errorf("FromMap() = %v, want %v", names, want)
+        }
+}
+
+func TestTuples(t *testing.T) {
+        entry, ok := AsEntry[string, int](Touple{"a", 1})
+        if key, value := entry.Unpack(); !ok || key != "a" || value != 1 {
+                t.Errorf("AsEntry() = %v, %v, want {a 1}", entry, ok)
+        }
+        if _, ok := AsEntry[string, string](Touple{"a", 1}); ok {
+                t.Errorf("AsEntry() should fail when the types do not match")
+        }
+        if entry.ToTouple() != (Touple{"a", 1}) {
+                t.Errorf("ToTouple() = %v, want {a 1}", entry.ToTouple())
+        }
+
+        if first, second := NewPair("a", 1).Unpack(); first != "a" || second != 1 {
+                t.Errorf("NewPair() = %v, %v, want a 1", first, second)
+        }
+        if first, second, third := NewTriple("a", 1, true).Unpack(); first != "a" || second != 1 || !third {
+                t.Errorf("NewTriple() = %v, %v, %v, want a 1 true", first, second, third)
+        }
+}
@@ -0,0 +1,24 @@
+package collection
+
+// ActionUntil is a function type that takes an index and a value of type T and returns whether the iteration must stop.
+// It is the control-flow counterpart of Action, used to stop a search or a bounded scan as soon as it is done.
+type ActionUntil[T any] func(int, T) (stop bool)
+
+// ForEachUntil applies the action function to each element in the source collection until the action returns true.
+// Stopping is not a failure: the error is nil unless the source is not valid or the action panics.
+// Parameters:
+//   - action: a function that takes an index and a value of type T, performs an action and returns true to stop.
+//   - source: the collection of elements to iterate over.
+//   - opts: options that customize the iteration, like ContinueOnError.
+//
+// Returns:
+//   - error: an error if the source is not of the appropriate type or if any other problem occurs during the operation.
+func ForEachUntil[T any](action ActionUntil[T], source any, opts ...Option) error {
+        var actionErr ActionErr[T] = func(index int, item T) error {
+                if action(index, item) {
+                        return ErrStop
+                }
+                return nil
+        }
+        return run(actionErr, source, newConfig(opts))
+}
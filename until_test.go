@@ -0,0 +1,49 @@
+package collection
+
+import (
+        "errors"
+        "fmt"
+        "reflect"
+        "testing"
+)
+
+func TestForEachUntil(t *testing.T) {
+        var found testUser
+        var search ActionUntil[testUser] = func(index int, tu testUser) bool {
+                found = tu
+                return tu.age > 40
+        }
+        if err := ForEachUntil(search, generateTestCaseList()); err != nil {
+                t.Fatalf("ForEachUntil failed: %v", err)
+        }
+        if !reflect.DeepEqual(sarah, found) {
+                t.Errorf("ForEachUntil() = %v, want %v", found, sarah)
+        }
+
+        visited := 0
+        if err := ForEachUntil(func(int, int) bool { visited++; return false }, generateNumbers(5)); err != nil || visited != 5 {
+                t.Errorf("ForEachUntil() = %v, %d, want every element visited", err, visited)
+        }
+}
+
+func TestErrStop(t *testing.T) {
+        var firstThree MapperErr[int] = func(n int) (any, error) {
+                if n == 3 {
+                        return nil, fmt.Errorf("enough: %w", ErrStop)
+                }
+                return n, nil
+        }
+        numbers := []int{}
+        if err := MapErr(firstThree, generateNumbers(10), &numbers, ContinueOnError()); err != nil {
+                t.Fatalf("MapErr failed: %v", err)
+        }
+        if want := []int{0, 1, 2}; !reflect.DeepEqual(want, numbers) {
+                t.Errorf("MapErr() = %v, want %v", numbers, want)
+        }
+
+        err := ForEach(func(int, int) { panic(ErrStop) }, generateNumbers(2))
+        var panicErr *PanicError
+        if !errors.As(err, &panicErr) {
+                t.Errorf("ForEach() = %v, want a panic with ErrStop reported as a failure", err)
+        }
+}
@@ -0,0 +1,80 @@
+package collection
+
+import (
+        "fmt"
+        "iter"
+        "reflect"
+)
+
+// validateSource checks, before iterating it, that the source is a collection whose elements can be read as K.
+// A nil map is accepted like a nil slice, since ranging over it is valid.
+// It returns an error wrapping ErrNilInput or ErrUnsupportedSource otherwise.
+func validateSource[K any](src any) error {
+        if isNil(src) && !IsMap(src) {
+                return fmt.Errorf("%w: the source is nil", ErrNilInput)
+        }
+        switch typed := src.(type) {
+        case []K, iter.Seq[K], func(func(K) bool), <-chan K, chan K:
+                return nil
+        case enumerable:
+                if typed.elemType().AssignableTo(reflect.TypeFor[K]()) {
+                        return nil
+                }
+        case keyed:
+                if acceptsEntries[K](typed.entryTypes()) {
+                        return nil
+                }
+        default:
+                if IsMap(src) {
+                        if mapType := reflect.TypeOf(src); acceptsEntries[K](mapType.Key(), mapType.Elem()) {
+                                return nil
+                        }
+                } else if _, ok := sliceOf[K](src); ok {
+                        return nil
+                }
+        }
+        return fmt.Errorf("%w: %T can not be iterated as elements of type %v", ErrUnsupportedSource, src, reflect.TypeFor[K]())
+}
+
+// acceptsEntries reports whether the entries of a map whose keys and values are of types keyType and valueType
+// can be read as K: K must be Touple, an interface implemented by Touple, like any, or an Entry whose types match.
+func acceptsEntries[K any](keyType, valueType reflect.Type) bool {
+        if reflect.TypeFor[Touple]().AssignableTo(reflect.TypeFor[K]()) {
+                return true
+        }
+        var zero K
+        builder, ok := any(zero).(entryBuilder)
+        return ok && builder.accepts(keyType, valueType)
+}
+
+// validateDest checks, before iterating the source, that the destination is a collection the operations can fill:
+// a map, a pointer to a slice, a channel that can be sent to or a collection of the package. It returns an error wrapping ErrNilInput or
+// ErrUnsupportedDestination otherwise.
+func validateDest(dest any) error {
+        if isNil(dest) {
+                return fmt.Errorf("%w: the destination is nil", ErrNilInput)
+        }
+        if _, ok := dest.(container); ok || IsMap(dest) {
+                return nil
+        }
+        destType := reflect.TypeOf(dest)
+        if destType.Kind() == reflect.Ptr && destType.Elem().Kind() == reflect.Slice {
+                return nil
+        }
+        if destType.Kind() == reflect.Chan && destType.ChanDir()&reflect.SendDir != 0 {
+                return nil
+        }
+        return fmt.Errorf("%w: %T is neither a map, a pointer to a slice nor a channel", ErrUnsupportedDestination, dest)
+}
+
+// isNil reports whether the value is nil, or a nil map, pointer, function or channel.
+func isNil(value any) bool {
+        if value == nil {
+                return true
+        }
+        switch val := reflect.ValueOf(value); val.Kind() {
+        case reflect.Map, reflect.Ptr, reflect.Func, reflect.Chan:
+                return val.IsNil()
+        }
+        return false
+}
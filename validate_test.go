@@ -0,0 +1,123 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "testing"
+)
+
+func TestValidation(t *testing.T) {
+        var nilMap map[string]int
+        var nilSlice *[]int
+        var nilSet *Set[int]
+        var action Action[int] = func(int, int) {}
+        var mapper Mapper[int] = func(n int) any { return n }
+
+        tests := []struct {
+                name string
+                got  error
+                want error
+        }{
+                {"ForEach over a string", ForEach(action, "123"), ErrUnsupportedSource},
+                {"ForEach over a slice of other type", ForEach(action, []string{"1"}), ErrUnsupportedSource},
+                {"ForEach over nil", ForEach(action, nil), ErrNilInput},
+                {"ForEach over a nil set", ForEach(action, nilSet), ErrNilInput},
+                {"ForEach over a set of other type", ForEach(action, NewSet("a")), ErrUnsupportedSource},
+                {"Filter into a slice", Filter(func(int) bool { return true }, []int{1}, []int{}), ErrUnsupportedDestination},
+                {"Map into nil", Map(mapper, []int{1}, nil), ErrNilInput},
+                {"Map into a nil map", Map(mapper, []int{1}, nilMap), ErrNilInput},
+                {"Map into a nil pointer", Map(mapper, []int{1}, nilSlice), ErrNilInput},
+                {"GroupBy into a struct", GroupBy(func(n int) any { return n }, []int{1}, testUser{}), ErrUnsupportedDestination},
+                {"ForEach over a map as int", ForEach(action, map[string]int{"a": 1}), ErrUnsupportedSource},
+                {"ForEach over a map as a mismatching Entry", ForEach(func(int, Entry[int, int]) {}, map[string]int{"a": 1}), ErrUnsupportedSource},
+                {"ForEach over an OrderedMap as a mismatching Entry", ForEach(func(int, Entry[string, string]) {}, NewOrderedMap[string, int]()), ErrUnsupportedSource},
+                {"ParallelMap over an int", ParallelMap(2, mapper, 1, &[]int{}), ErrUnsupportedSource},
+                {"Reduce over nil", func() error { _, err := Reduce(sum, nil); return err }(), ErrNilInput},
+                {"SortBy a slice", SortBy(func(a, b int) int { return a - b }, []int{}), ErrUnsupportedSource},
+                {"SortBy a pointer to other type", SortBy(func(a, b int) int { return a - b }, &[]string{}), ErrUnsupportedSource},
+                {"SortBy nil", SortBy(func(a, b int) int { return a - b }, nilSlice), ErrNilInput},
+                {"Zip with different lengths", Zip([]string{"a"}, []int{}, map[string]int{}).Error(), ErrLengthMismatch},
+                {"Zip into a nil map", Zip([]string{"a"}, []int{1}, nilMap).Error(), ErrNilInput},
+        }
+        for _, tt := range tests {
+                t.Run(tt.name, func(t *testing.T) {
+                        if !errors.Is(tt.got, tt.want) {
+                                t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+                        }
+                })
+        }
+
+        if IsMap(nil) || IsListUpdatable(nil) {
+                t.Errorf("IsMap(nil) and IsListUpdatable(nil) should be false")
+        }
+}
+
+func TestListSources(t *testing.T) {
+        isEven := func(n int) bool { return n%2 == 0 }
+        slice := []int{1, 2, 3, 4}
+        array := [4]int{1, 2, 3, 4}
+
+        for name, source := range map[string]any{"slice": slice, "pointer to slice": &slice, "array": array, "pointer to array": &array} {
+                t.Run(name, func(t *testing.T) {
+                        evens := []int{}
+                        if err := Filter(isEven, source, &evens); err != nil {
+                                t.Fatalf("Filter failed: %v", err)
+                        }
+                        if want := []int{2, 4}; !reflect.DeepEqual(want, evens) {
+                                t.Errorf("Filter() = %v, want %v", evens, want)
+                        }
+                })
+        }
+
+        if err := ForEach(func(int, string) {}, &array); !errors.Is(err, ErrUnsupportedSource) {
+                t.Errorf("ForEach() = %v, want %v", err, ErrUnsupportedSource)
+        }
+}
+
+type people []int
+
+func TestNamedSliceSources(t *testing.T) {
+        named := people{3, 1, 2}
+        visited := 0
+        if err := ForEach(func(int, int) { visited++ }, named); err != nil || visited != 3 {
+                t.Errorf("ForEach() = %v, %d, want the named slice iterated", err, visited)
+        }
+        if err := SortBy(func(a, b int) int { return a - b }, &named); err != nil {
+                t.Fatalf("SortBy failed: %v", err)
+        }
+        if want := (people{1, 2, 3}); !reflect.DeepEqual(want, named) {
+                t.Errorf("SortBy() = %v, want %v", named, want)
+        }
+        if err := ForEach(func(int, string) {}, named); !errors.Is(err, ErrUnsupportedSource) {
+                t.Errorf("ForEach() = %v, want %v", err, ErrUnsupportedSource)
+        }
+}
+
+func TestSortByArray(t *testing.T) {
+        array := [5]int{5, 3, 4, 1, 2}
+        if err := SortBy(func(a, b int) int { return a - b }, &array); err != nil {
+                t.Fatalf("SortBy failed: %v", err)
+        }
+        if want := [5]int{1, 2, 3, 4, 5}; want != array {
+                t.Errorf("SortBy() = %v, want %v", array, want)
+        }
+        if err := SortBy(func(a, b int) int { return a - b }, array); !errors.Is(err, ErrUnsupportedSource) {
+                t.Errorf("SortBy() = %v, want %v", err, ErrUnsupportedSource)
+        }
+}
+
+func TestMapSources(t *testing.T) {
+        visited := 0
+        count := func(int, any) { visited++ }
+        if err := ForEach(count, map[string]int{"a": 1, "b": 2}); err != nil || visited != 2 {
+                t.Errorf("ForEach() = %v, %d, want the entries iterated as any", err, visited)
+        }
+        if err := ForEach(func(int, Entry[string, any]) { visited++ }, map[string]any{"a": 1}); err != nil || visited != 3 {
+                t.Errorf("ForEach() = %v, %d, want the entries iterated as Entry", err, visited)
+        }
+
+        var nilMap map[string]int
+        if err := ForEach(func(int, Touple) { visited++ }, nilMap); err != nil || visited != 3 {
+                t.Errorf("ForEach() = %v, %d, want a nil map iterated like an empty one", err, visited)
+        }
+}
@@ -0,0 +1,116 @@
+package collection
+
+import (
+        "fmt"
+        "slices"
+)
+
+// LengthPolicy decides how the zipping operations handle inputs of different lengths.
+type LengthPolicy int
+
+const (
+        // LengthStrict rejects inputs of different lengths with an error wrapping ErrLengthMismatch. It is the default.
+        LengthStrict LengthPolicy = iota
+        // LengthShortest stops at the end of the shortest input, discarding the remaining elements of the others.
+        LengthShortest
+        // LengthLongest goes on until the end of the longest input, using the zero value for the missing elements.
+        LengthLongest
+)
+
+// WithLengthPolicy sets how Zip, ZipToPairs, ZipWith and Zip3 handle inputs of different lengths.
+// Zip never pads the keys: with LengthLongest, more values than keys is still rejected with ErrLengthMismatch.
+func WithLengthPolicy(policy LengthPolicy) Option {
+        return func(cfg *config) {
+                cfg.lengthPolicy = policy
+        }
+}
+
+// zipLength returns the number of elements produced when zipping inputs of the given lengths, following the
+// length policy of the configuration.
+func (cfg config) zipLength(lengths ...int) (int, error) {
+        switch cfg.lengthPolicy {
+        case LengthShortest:
+                return slices.Min(lengths), nil
+        case LengthLongest:
+                return slices.Max(lengths), nil
+        }
+        if slices.Min(lengths) != slices.Max(lengths) {
+                return 0, fmt.Errorf("%w: the inputs must have the same length, got %v", ErrLengthMismatch, lengths)
+        }
+        return lengths[0], nil
+}
+
+// elementAt returns the element at the index, or the zero value of T when the index is out of the slice.
+func elementAt[T any](items []T, index int) (item T) {
+        if index < len(items) {
+                item = items[index]
+        }
+        return
+}
+
+// ZipToPairs combines two slices into a slice of pairs, where the i-th pair holds the i-th element of each slice.
+// Unlike Zip, repeated elements are kept.
+// Parameters:
+//   - first: the slice whose elements are the First field of the pairs.
+//   - second: the slice whose elements are the Second field of the pairs.
+//   - opts: options that customize the operation, like WithLengthPolicy.
+//
+// Returns:
+//   - []Pair[A, B]: the pairs, in the order of the slices.
+//   - error: an error wrapping ErrLengthMismatch if the slices have different lengths and the policy is LengthStrict.
+func ZipToPairs[A, B any](first []A, second []B, opts ...Option) ([]Pair[A, B], error) {
+        return ZipWith(NewPair[A, B], first, second, opts...)
+}
+
+// ZipWith combines two slices element by element using the combine function.
+// Parameters:
+//   - combine: a function that takes the i-th element of each slice and returns the i-th element of the result.
+//   - first: the slice whose elements are the first argument of combine.
+//   - second: the slice whose elements are the second argument of combine.
+//   - opts: options that customize the operation, like WithLengthPolicy.
+//
+// Returns:
+//   - []R: the combined elements, in the order of the slices.
+//   - error: an error wrapping ErrLengthMismatch if the slices have different lengths and the policy is LengthStrict.
+func ZipWith[A, B, R any](combine func(A, B) R, first []A, second []B, opts ...Option) ([]R, error) {
+        length, err := newConfig(opts).zipLength(len(first), len(second))
+        if err != nil {
+                return nil, err
+        }
+        result := make([]R, length)
+        for index := range result {
+                result[index] = combine(elementAt(first, index), elementAt(second, index))
+        }
+        return result, nil
+}
+
+// Zip3 combines three slices into a slice of triples, where the i-th triple holds the i-th element of each slice.
+// Parameters:
+//   - first, second, third: the slices whose elements are the fields of the triples.
+//   - opts: options that customize the operation, like WithLengthPolicy.
+//
+// Returns:
+//   - []Triple[A, B, C]: the triples, in the order of the slices.
+//   - error: an error wrapping ErrLengthMismatch if the slices have different lengths and the policy is LengthStrict.
+func Zip3[A, B, C any](first []A, second []B, third []C, opts ...Option) ([]Triple[A, B, C], error) {
+        length, err := newConfig(opts).zipLength(len(first), len(second), len(third))
+        if err != nil {
+                return nil, err
+        }
+        result := make([]Triple[A, B, C], length)
+        for index := range result {
+                result[index] = NewTriple(elementAt(first, index), elementAt(second, index), elementAt(third, index))
+        }
+        return result, nil
+}
+
+// Unzip splits a slice of pairs back into two slices, the first holding the First field of every pair
+// and the second holding the Second field.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+        first := make([]A, len(pairs))
+        second := make([]B, len(pairs))
+        for index, pair := range pairs {
+                first[index], second[index] = pair.Unpack()
+        }
+        return first, second
+}
@@ -0,0 +1,78 @@
+package collection
+
+import (
+        "errors"
+        "reflect"
+        "strconv"
+        "testing"
+)
+
+func TestZipToPairsAndUnzip(t *testing.T) {
+        names := []string{"John", "Sarah", "John"}
+        ages := []int{10, 43, 11}
+        pairs, err := ZipToPairs(names, ages)
+        if err != nil {
+                t.Fatalf("ZipToPairs failed: %v", err)
+        }
+        if want := []Pair[string, int]{{"John", 10}, {"Sarah", 43}, {"John", 11}}; !reflect.DeepEqual(want, pairs) {
+                t.Errorf("ZipToPairs() = %v, want %v", pairs, want)
+        }
+        gotNames, gotAges := Unzip(pairs)
+        if !reflect.DeepEqual(names, gotNames) || !reflect.DeepEqual(ages, gotAges) {
+                t.Errorf("Unzip() = %v, %v, want %v, %v", gotNames, gotAges, names, ages)
+        }
+
+        if _, err := ZipToPairs(names, ages[:2]); !errors.Is(err, ErrLengthMismatch) {
+                t.Errorf("ZipToPairs() = %v, want ErrLengthMismatch", err)
+        }
+}
+
+func TestZipWithLengthPolicy(t *testing.T) {
+        label := func(name string, age int) string {
+                return name + ":" + strconv.Itoa(age)
+        }
+        shortest, err := ZipWith(label, []string{"a", "b", "c"}, []int{1, 2}, WithLengthPolicy(LengthShortest))
+        if want := []string{"a:1", "b:2"}; err != nil || !reflect.DeepEqual(want, shortest) {
+                t.Errorf("ZipWith() = %v, %v, want %v", shortest, err, want)
+        }
+        longest, err := ZipWith(label, []string{"a", "b", "c"}, []int{1, 2}, WithLengthPolicy(LengthLongest))
+        if want := []string{"a:1", "b:2", "c:0"}; err != nil || !reflect.DeepEqual(want, longest) {
+                t.Errorf("ZipWith() = %v, %v, want %v", longest, err, want)
+        }
+
+        triples, err := Zip3([]string{"a"}, []int{1, 2}, []bool{true}, WithLengthPolicy(LengthLongest))
+        if want := []Triple[string, int, bool]{{"a", 1, true}, {"", 2, false}}; err != nil || !reflect.DeepEqual(want, triples) {
+                t.Errorf("Zip3() = %v, %v, want %v", triples, err, want)
+        }
+        if _, err := Zip3([]string{"a"}, []int{1, 2}, []bool{true}); !errors.Is(err, ErrLengthMismatch) {
+                t.Errorf("Zip3() = %v, want ErrLengthMismatch", err)
+        }
+
+        result := map[string]int{}
+        if err := Zip([]string{"a", "b"}, []int{1}, result, WithLengthPolicy(LengthShortest)).Error(); err != nil {
+                t.Fatalf("Zip failed: %v", err)
+        }
+        if want := map[string]int{"a": 1}; !reflect.DeepEqual(want, result) {
+                t.Errorf("Zip() = %v, want %v", result, want)
+        }
+
+        padded := map[string]int{}
+        if err := Zip([]string{"a", "b"}, []int{1}, padded, WithLengthPolicy(LengthLongest)).Error(); err != nil {
+                t.Fatalf("Zip failed: %v", err)
+        }
+        if want := map[string]int{"a": 1, "b": 0}; !reflect.DeepEqual(want, padded) {
+                t.Errorf("Zip() = %v, want %v", padded, want)
+        }
+        missingKeys := map[int]string{}
+        err = Zip([]int{1}, []string{"a", "b"}, missingKeys, WithLengthPolicy(LengthLongest)).Error()
+        if !errors.Is(err, ErrLengthMismatch) || len(missingKeys) != 0 {
+                t.Errorf("Zip() = %v, %v, want ErrLengthMismatch and no keys", missingKeys, err)
+        }
+}
+
+func TestZipBuilderError(t *testing.T) {
+        var builder *BuilderError[string] = Zip([]string{"a", "b", "a"}, []int{1, 2, 3}, map[string]int{}, WithMerge(MergeErrorOnDuplicate))
+        if !errors.Is(builder.Error(), ErrDuplicateKey) || builder.Index() != 2 || builder.Item() != "a" {
+                t.Errorf("Zip() = %v at %d with %q, want the duplicate key a at index 2", builder.Error(), builder.Index(), builder.Item())
+        }
+}